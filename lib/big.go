@@ -0,0 +1,32 @@
+package lib
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BigAdd は、a+bをbig.Intとして返します. CheckedAddIntがオーバーフローを報告した場合の代替として利用できます.
+func BigAdd(a, b int) *big.Int {
+	return new(big.Int).Add(big.NewInt(int64(a)), big.NewInt(int64(b)))
+}
+
+// BigMul は、a*bをbig.Intとして返します. CheckedMulIntがオーバーフローを報告した場合の代替として利用できます.
+func BigMul(a, b int) *big.Int {
+	return new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b)))
+}
+
+// BigPow は、xのn乗をbig.Intとして返します. CheckedPowIntがオーバーフローを報告した場合の代替として利用できます.
+func BigPow(x, n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("negative n is given: %d", n)
+	}
+	return new(big.Int).Exp(big.NewInt(int64(x)), big.NewInt(int64(n)), nil), nil
+}
+
+// BigToInt は、vをintに変換します. intの範囲に収まらない場合はエラーを返します.
+func BigToInt(v *big.Int) (int, error) {
+	if !v.IsInt64() {
+		return 0, fmt.Errorf("%v overflows int", v)
+	}
+	return int(v.Int64()), nil
+}
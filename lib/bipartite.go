@@ -0,0 +1,165 @@
+package lib
+
+import "fmt"
+
+// IsBipartite は、隣接リストで表される無向グラフが二部グラフであるかを判定します.
+// 二部グラフである場合は、各頂点を0と1で塗り分けた結果も返します. 計算量はO(V+E)です.
+func IsBipartite(list [][]int) (colors []int, ok bool) {
+	colors = NewIntSliceWithInitialValue(len(list), -1)
+	for start := range list {
+		if colors[start] >= 0 {
+			continue
+		}
+		colors[start] = 0
+		queue := []int{start}
+		for head := 0; head < len(queue); head++ {
+			v := queue[head]
+			for _, next := range list[v] {
+				if colors[next] < 0 {
+					colors[next] = colors[v] ^ 1
+					queue = append(queue, next)
+				} else if colors[next] == colors[v] {
+					return nil, false
+				}
+			}
+		}
+	}
+	return colors, true
+}
+
+// BipartiteMatching は、左側leftNum頂点、右側rightNum頂点からなる二部グラフのマッチングを求めます.
+type BipartiteMatching struct {
+	leftNum, rightNum int
+	adj               [][]int
+	matchL, matchR    []int
+	dist              []int
+}
+
+// NewBipartiteMatching は、辺のない二部グラフを返します.
+func NewBipartiteMatching(leftNum, rightNum int) (*BipartiteMatching, error) {
+	if leftNum < 0 || rightNum < 0 {
+		return nil, fmt.Errorf("invalid node num. left:%d right:%d", leftNum, rightNum)
+	}
+	return &BipartiteMatching{
+		leftNum:  leftNum,
+		rightNum: rightNum,
+		adj:      make([][]int, leftNum),
+		matchL:   NewIntSliceWithInitialValue(leftNum, -1),
+		matchR:   NewIntSliceWithInitialValue(rightNum, -1),
+		dist:     make([]int, leftNum),
+	}, nil
+}
+
+// AddEdge は、左側の頂点lと右側の頂点rの間に辺を追加します.
+func (b *BipartiteMatching) AddEdge(l, r int) error {
+	if l < 0 || l >= b.leftNum {
+		return fmt.Errorf("left node(%d) is out of range [0, %d)", l, b.leftNum)
+	}
+	if r < 0 || r >= b.rightNum {
+		return fmt.Errorf("right node(%d) is out of range [0, %d)", r, b.rightNum)
+	}
+	b.adj[l] = append(b.adj[l], r)
+	return nil
+}
+
+// MaxMatching は、Hopcroft-Karp法で最大マッチングを求め、マッチした(左側の頂点, 右側の頂点)の組を左側の頂点順に返します.
+// 計算量はO(E√V)です.
+func (b *BipartiteMatching) MaxMatching() [][2]int {
+	for b.bfs() {
+		for l := 0; l < b.leftNum; l++ {
+			if b.matchL[l] < 0 {
+				b.dfs(l)
+			}
+		}
+	}
+
+	var pairs [][2]int
+	for l, r := range b.matchL {
+		if r >= 0 {
+			pairs = append(pairs, [2]int{l, r})
+		}
+	}
+	return pairs
+}
+
+// bfs は、未マッチの左側の頂点からの交互路の長さを求め、増加路が存在するかを返します.
+func (b *BipartiteMatching) bfs() bool {
+	var queue []int
+	for l := 0; l < b.leftNum; l++ {
+		if b.matchL[l] < 0 {
+			b.dist[l] = 0
+			queue = append(queue, l)
+		} else {
+			b.dist[l] = -1
+		}
+	}
+
+	found := false
+	for head := 0; head < len(queue); head++ {
+		l := queue[head]
+		for _, r := range b.adj[l] {
+			nextL := b.matchR[r]
+			if nextL < 0 {
+				found = true
+			} else if b.dist[nextL] < 0 {
+				b.dist[nextL] = b.dist[l] + 1
+				queue = append(queue, nextL)
+			}
+		}
+	}
+	return found
+}
+
+func (b *BipartiteMatching) dfs(l int) bool {
+	for _, r := range b.adj[l] {
+		nextL := b.matchR[r]
+		if nextL < 0 || (b.dist[nextL] == b.dist[l]+1 && b.dfs(nextL)) {
+			b.matchL[l] = r
+			b.matchR[r] = l
+			return true
+		}
+	}
+	b.dist[l] = -1
+	return false
+}
+
+// MinVertexCover は、Kőnigの定理により最小頂点被覆を求め、被覆に含まれる左側の頂点と右側の頂点をそれぞれ昇順で返します.
+// 最小頂点被覆の大きさは最大マッチングの大きさと等しくなります.
+func (b *BipartiteMatching) MinVertexCover() (left []int, right []int) {
+	b.MaxMatching()
+
+	visitedL := make([]bool, b.leftNum)
+	visitedR := make([]bool, b.rightNum)
+	var queue []int
+	for l := 0; l < b.leftNum; l++ {
+		if b.matchL[l] < 0 {
+			visitedL[l] = true
+			queue = append(queue, l)
+		}
+	}
+	for head := 0; head < len(queue); head++ {
+		l := queue[head]
+		for _, r := range b.adj[l] {
+			if visitedR[r] || b.matchL[l] == r {
+				continue
+			}
+			visitedR[r] = true
+			if nextL := b.matchR[r]; nextL >= 0 && !visitedL[nextL] {
+				visitedL[nextL] = true
+				queue = append(queue, nextL)
+			}
+		}
+	}
+
+	for l, visited := range visitedL {
+		if !visited {
+			left = append(left, l)
+		}
+	}
+	for r, visited := range visitedR {
+		if visited {
+			right = append(right, r)
+		}
+	}
+	return
+}
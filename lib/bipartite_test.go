@@ -0,0 +1,251 @@
+package lib
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// bruteForceMaxMatching は、辺の部分集合を全て列挙して最大マッチングの大きさを返します.
+func bruteForceMaxMatching(leftNum, rightNum int, edges [][2]int) int {
+	max := 0
+	for bits := 0; bits < 1<<len(edges); bits++ {
+		usedL := make([]bool, leftNum)
+		usedR := make([]bool, rightNum)
+		size, ok := 0, true
+		for i, e := range edges {
+			if bits>>i&1 == 0 {
+				continue
+			}
+			if usedL[e[0]] || usedR[e[1]] {
+				ok = false
+				break
+			}
+			usedL[e[0]], usedR[e[1]] = true, true
+			size++
+		}
+		if ok && size > max {
+			max = size
+		}
+	}
+	return max
+}
+
+func TestIsBipartite(t *testing.T) {
+	type args struct {
+		x      []int
+		y      []int
+		length int
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantColors []int
+		wantOk     bool
+	}{
+		{
+			name: "IsBipartite",
+			args: args{
+				// 0 - 1 - 2 - 3 - 0
+				x:      []int{0, 1, 2, 3},
+				y:      []int{1, 2, 3, 0},
+				length: 4,
+			},
+			wantColors: []int{0, 1, 0, 1},
+			wantOk:     true,
+		},
+		{
+			name: "IsBipartite",
+			args: args{
+				// 0 - 1 - 2 - 0, 3 - 4
+				x:      []int{0, 1, 2, 3},
+				y:      []int{1, 2, 0, 4},
+				length: 5,
+			},
+			wantColors: nil,
+			wantOk:     false,
+		},
+		{
+			name: "IsBipartite",
+			args: args{
+				// 0 - 1, 2, 3 - 4
+				x:      []int{0, 3},
+				y:      []int{1, 4},
+				length: 5,
+			},
+			wantColors: []int{0, 1, 0, 0, 1},
+			wantOk:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := MustAdjacencyList(tt.args.x, tt.args.y, tt.args.length)
+			gotColors, gotOk := IsBipartite(list)
+			if !reflect.DeepEqual(gotColors, tt.wantColors) {
+				t.Errorf("IsBipartite() gotColors = %v, want %v", gotColors, tt.wantColors)
+			}
+			if gotOk != tt.wantOk {
+				t.Errorf("IsBipartite() gotOk = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestBipartiteMatching_AddEdge(t *testing.T) {
+	tests := []struct {
+		name    string
+		l, r    int
+		wantErr bool
+	}{
+		{name: "BipartiteMatching_AddEdge", l: 1, r: 2, wantErr: false},
+		{name: "BipartiteMatching_AddEdge", l: 2, r: 0, wantErr: true},
+		{name: "BipartiteMatching_AddEdge", l: 0, r: 3, wantErr: true},
+		{name: "BipartiteMatching_AddEdge", l: -1, r: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := MustNewBipartiteMatching(2, 3)
+			if err := b.AddEdge(tt.l, tt.r); (err != nil) != tt.wantErr {
+				t.Errorf("BipartiteMatching.AddEdge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBipartiteMatching_MaxMatching(t *testing.T) {
+	type args struct {
+		leftNum  int
+		rightNum int
+		edges    [][2]int
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           [][2]int
+		wantCoverLeft  []int
+		wantCoverRight []int
+	}{
+		{
+			name: "BipartiteMatching_MaxMatching",
+			args: args{
+				leftNum:  3,
+				rightNum: 3,
+				edges:    [][2]int{{0, 0}, {0, 1}, {1, 0}, {2, 1}, {2, 2}},
+			},
+			want:           [][2]int{{0, 1}, {1, 0}, {2, 2}},
+			wantCoverLeft:  []int{0, 1, 2},
+			wantCoverRight: nil,
+		},
+		{
+			name: "BipartiteMatching_MaxMatching",
+			args: args{
+				leftNum:  3,
+				rightNum: 2,
+				edges:    [][2]int{{0, 0}, {1, 0}, {2, 0}, {2, 1}},
+			},
+			want:           [][2]int{{0, 0}, {2, 1}},
+			wantCoverLeft:  []int{2},
+			wantCoverRight: []int{0},
+		},
+		{
+			// 0 - 0を先にマッチさせると、増加路0 - 1, 1 - 0で付け替える必要がある
+			name: "BipartiteMatching_MaxMatching",
+			args: args{
+				leftNum:  2,
+				rightNum: 2,
+				edges:    [][2]int{{0, 0}, {0, 1}, {1, 0}},
+			},
+			want:           [][2]int{{0, 1}, {1, 0}},
+			wantCoverLeft:  []int{0, 1},
+			wantCoverRight: nil,
+		},
+		{
+			// 多重辺
+			name: "BipartiteMatching_MaxMatching",
+			args: args{
+				leftNum:  1,
+				rightNum: 1,
+				edges:    [][2]int{{0, 0}, {0, 0}},
+			},
+			want:           [][2]int{{0, 0}},
+			wantCoverLeft:  []int{0},
+			wantCoverRight: nil,
+		},
+		{
+			name: "BipartiteMatching_MaxMatching",
+			args: args{
+				leftNum:  2,
+				rightNum: 2,
+				edges:    nil,
+			},
+			want:           nil,
+			wantCoverLeft:  nil,
+			wantCoverRight: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := MustNewBipartiteMatching(tt.args.leftNum, tt.args.rightNum)
+			for _, e := range tt.args.edges {
+				b.MustAddEdge(e[0], e[1])
+			}
+			if got := b.MaxMatching(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BipartiteMatching.MaxMatching() = %v, want %v", got, tt.want)
+			}
+			gotLeft, gotRight := b.MinVertexCover()
+			if !reflect.DeepEqual(gotLeft, tt.wantCoverLeft) {
+				t.Errorf("BipartiteMatching.MinVertexCover() left = %v, want %v", gotLeft, tt.wantCoverLeft)
+			}
+			if !reflect.DeepEqual(gotRight, tt.wantCoverRight) {
+				t.Errorf("BipartiteMatching.MinVertexCover() right = %v, want %v", gotRight, tt.wantCoverRight)
+			}
+		})
+	}
+}
+
+func TestBipartiteMatching_MaxMatching_BruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		leftNum, rightNum := 1+r.Intn(5), 1+r.Intn(5)
+		var edges [][2]int
+		for j := r.Intn(12); j > 0; j-- {
+			edges = append(edges, [2]int{r.Intn(leftNum), r.Intn(rightNum)})
+		}
+		b := MustNewBipartiteMatching(leftNum, rightNum)
+		for _, e := range edges {
+			b.MustAddEdge(e[0], e[1])
+		}
+
+		want := bruteForceMaxMatching(leftNum, rightNum, edges)
+		pairs := b.MaxMatching()
+		if len(pairs) != want {
+			t.Fatalf("len(BipartiteMatching.MaxMatching()) = %v, want %v, edges: %v", len(pairs), want, edges)
+		}
+		usedL := make([]bool, leftNum)
+		usedR := make([]bool, rightNum)
+		for _, p := range pairs {
+			if usedL[p[0]] || usedR[p[1]] {
+				t.Fatalf("BipartiteMatching.MaxMatching() = %v is not matching", pairs)
+			}
+			usedL[p[0]], usedR[p[1]] = true, true
+		}
+
+		left, right := b.MinVertexCover()
+		if len(left)+len(right) != want {
+			t.Fatalf("size of BipartiteMatching.MinVertexCover() = %v, want %v, edges: %v", len(left)+len(right), want, edges)
+		}
+		coveredL := make([]bool, leftNum)
+		coveredR := make([]bool, rightNum)
+		for _, l := range left {
+			coveredL[l] = true
+		}
+		for _, r := range right {
+			coveredR[r] = true
+		}
+		for _, e := range edges {
+			if !coveredL[e[0]] && !coveredR[e[1]] {
+				t.Fatalf("BipartiteMatching.MinVertexCover() = (%v, %v) does not cover %v", left, right, e)
+			}
+		}
+	}
+}
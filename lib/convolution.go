@@ -0,0 +1,364 @@
+package lib
+
+import "fmt"
+
+// NTTMod は、Convolutionや形式的冪級数(FPS)の演算で利用する法です.
+const NTTMod = 998244353
+
+// 任意modの畳み込みで利用する3つのNTT素数です. 原始根はそれぞれ11, 3, 3です.
+const (
+	nttMod1 = 754974721 // 45*2^24+1
+	nttMod2 = 167772161 // 5*2^25+1
+	nttMod3 = 469762049 // 7*2^26+1
+)
+
+// convolutionNaiveThreshold 以下の長さの数列同士は、NTTを使わず愚直に畳み込みます.
+const convolutionNaiveThreshold = 60
+
+// ntt は、aをmodと原始根gによって数論変換します. aの長さは2の冪である必要があります.
+// invertがtrueの場合は逆変換を行います.
+func ntt(a []int, invert bool, mod, g int) {
+	n := len(a)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+
+	ws := make([]int, n/2)
+	for length := 2; length <= n; length <<= 1 {
+		w := ModPow(g, (mod-1)/length, mod)
+		if invert {
+			w = ModPow(w, mod-2, mod)
+		}
+		half := length / 2
+		ws[0] = 1
+		for i := 1; i < half; i++ {
+			ws[i] = ws[i-1] * w % mod
+		}
+		for i := 0; i < n; i += length {
+			for j := 0; j < half; j++ {
+				u, v := a[i+j], a[i+j+half]*ws[j]%mod
+				a[i+j] = u + v
+				if a[i+j] >= mod {
+					a[i+j] -= mod
+				}
+				a[i+j+half] = u - v
+				if a[i+j+half] < 0 {
+					a[i+j+half] += mod
+				}
+			}
+		}
+	}
+
+	if invert {
+		nInv := ModPow(n, mod-2, mod)
+		for i := range a {
+			a[i] = a[i] * nInv % mod
+		}
+	}
+}
+
+// convolutionWithMod は、[0, mod)に正規化済みのaとbをNTT素数modで畳み込みます. 長さの検証は行いません.
+func convolutionWithMod(a, b []int, mod, g int) []int {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	resLen := len(a) + len(b) - 1
+	if len(a) <= convolutionNaiveThreshold || len(b) <= convolutionNaiveThreshold {
+		res := make([]int, resLen)
+		for i, x := range a {
+			for j, y := range b {
+				res[i+j] = (res[i+j] + x*y) % mod
+			}
+		}
+		return res
+	}
+
+	size := 1
+	for size < resLen {
+		size <<= 1
+	}
+	fa, fb := make([]int, size), make([]int, size)
+	copy(fa, a)
+	copy(fb, b)
+	ntt(fa, false, mod, g)
+	ntt(fb, false, mod, g)
+	for i := range fa {
+		fa[i] = fa[i] * fb[i] % mod
+	}
+	ntt(fa, true, mod, g)
+	return fa[:resLen]
+}
+
+// normalizeMod は、aの各要素を[0, mod)に正規化したsliceを返します.
+func normalizeMod(a []int, mod int) []int {
+	res := make([]int, len(a))
+	for i, v := range a {
+		res[i] = (v%mod + mod) % mod
+	}
+	return res
+}
+
+func validateConvolutionLength(a, b []int, maxLen int) error {
+	if len(a) > 0 && len(b) > 0 && len(a)+len(b)-1 > maxLen {
+		return fmt.Errorf("too long convolution. len(a)+len(b)-1 must be less than or equal to %d: %d", maxLen, len(a)+len(b)-1)
+	}
+	return nil
+}
+
+// Convolution は、c[k] = Σ_{i+j=k} a[i]*b[j]をNTTModで割ったあまりを返します.
+// 計算量はN=len(a)+len(b)としてO(N log N)です. 結果の長さは2^23以下である必要があります.
+func Convolution(a, b []int) ([]int, error) {
+	if err := validateConvolutionLength(a, b, 1<<23); err != nil {
+		return nil, err
+	}
+	return convolutionWithMod(normalizeMod(a, NTTMod), normalizeMod(b, NTTMod), NTTMod, 3), nil
+}
+
+// convolution3Primes は、3つのNTT素数それぞれで畳み込んだ結果を返します.
+func convolution3Primes(a, b []int) (c1, c2, c3 []int) {
+	c1 = convolutionWithMod(normalizeMod(a, nttMod1), normalizeMod(b, nttMod1), nttMod1, 11)
+	c2 = convolutionWithMod(normalizeMod(a, nttMod2), normalizeMod(b, nttMod2), nttMod2, 3)
+	c3 = convolutionWithMod(normalizeMod(a, nttMod3), normalizeMod(b, nttMod3), nttMod3, 3)
+	return
+}
+
+// ConvolutionMod は、c[k] = Σ_{i+j=k} a[i]*b[j]を任意のmodで割ったあまりを返します.
+// 3つのNTT素数で畳み込んだ結果を中国剰余定理(Garnerのアルゴリズム)で復元するため、1e9+7などの法でも利用できます.
+// modは1以上2^31以下、結果の長さは2^24以下である必要があります.
+func ConvolutionMod(a, b []int, mod int) ([]int, error) {
+	if mod < 1 || mod > 1<<31 {
+		return nil, fmt.Errorf("mod must be in [1, 2^31]: %d", mod)
+	}
+	if err := validateConvolutionLength(a, b, 1<<24); err != nil {
+		return nil, err
+	}
+	// 結果の各要素は3つの素数の積未満になるため、中国剰余定理で一意に復元できる
+	c1, c2, c3 := convolution3Primes(normalizeMod(a, mod), normalizeMod(b, mod))
+
+	m1InvM2 := ModPow(nttMod1, nttMod2-2, nttMod2)
+	m12InvM3 := ModPow(nttMod1*nttMod2%nttMod3, nttMod3-2, nttMod3)
+	m12 := nttMod1 * nttMod2 % mod
+	res := make([]int, len(c1))
+	for i := range res {
+		t2 := (c2[i] - c1[i]%nttMod2 + nttMod2) % nttMod2 * m1InvM2 % nttMod2
+		x12 := (c1[i] + nttMod1%nttMod3*t2) % nttMod3
+		t3 := (c3[i] - x12 + nttMod3) % nttMod3 * m12InvM3 % nttMod3
+		res[i] = (c1[i]%mod + nttMod1%mod*t2%mod + m12*t3%mod) % mod
+	}
+	return res, nil
+}
+
+// ConvolutionInt は、c[k] = Σ_{i+j=k} a[i]*b[j]を剰余を取らずに返します.
+// 場合の数の数え上げなど、結果の各要素がint64の範囲に収まる場合に利用できます. aやbは負の値を含んでいても構いません.
+// 結果の長さは2^24以下である必要があります.
+func ConvolutionInt(a, b []int) ([]int, error) {
+	if err := validateConvolutionLength(a, b, 1<<24); err != nil {
+		return nil, err
+	}
+	c1, c2, c3 := convolution3Primes(a, b)
+
+	// 2^64を法として、3つの素数の積を法とする値を復元し、その後2^64の範囲に収まるよう補正します.
+	m1, m2, m3 := uint64(nttMod1), uint64(nttMod2), uint64(nttMod3)
+	m2m3, m1m3, m1m2 := m2*m3, m1*m3, m1*m2
+	m1m2m3 := m1m2 * m3
+	i1 := uint64(ModPow(int(m2m3%m1), nttMod1-2, nttMod1))
+	i2 := uint64(ModPow(int(m1m3%m2), nttMod2-2, nttMod2))
+	i3 := uint64(ModPow(int(m1m2%m3), nttMod3-2, nttMod3))
+	offset := []uint64{0, 0, m1m2m3, 2 * m1m2m3, 3 * m1m2m3}
+
+	res := make([]int, len(c1))
+	for i := range res {
+		var x uint64
+		x += uint64(c1[i]) * i1 % m1 * m2m3
+		x += uint64(c2[i]) * i2 % m2 * m1m3
+		x += uint64(c3[i]) * i3 % m3 * m1m2
+		diff := c1[i] - (int(int64(x))%nttMod1+nttMod1)%nttMod1
+		if diff < 0 {
+			diff += nttMod1
+		}
+		x -= offset[diff%5]
+		res[i] = int(int64(x))
+	}
+	return res, nil
+}
+
+// 以下は、NTTModを法とする形式的冪級数(FPS)の演算です. fはf[i]をx^iの係数とする多項式として扱い、
+// 結果は先頭n項(x^0からx^(n-1)までの係数)を返します.
+
+func validateFPSLength(n int) error {
+	if n < 0 || n > 1<<21 {
+		return fmt.Errorf("n must be in [0, 2^21]: %d", n)
+	}
+	return nil
+}
+
+// fpsPrefix は、fの先頭n項を、足りない分を0で埋めて返します.
+func fpsPrefix(f []int, n int) []int {
+	res := make([]int, n)
+	copy(res, f)
+	return normalizeMod(res, NTTMod)
+}
+
+func fpsMul(f, g []int, n int) []int {
+	res := convolutionWithMod(f, g, NTTMod, 3)
+	return fpsPrefix(res, n)
+}
+
+// FPSInv は、f*g = 1を満たすgの先頭n項を返します. f[0]がNTTModの倍数の場合はエラーを返します.
+// ニュートン法により、計算量はO(n log n)です.
+func FPSInv(f []int, n int) ([]int, error) {
+	if err := validateFPSLength(n); err != nil {
+		return nil, err
+	}
+	if len(f) == 0 || f[0]%NTTMod == 0 {
+		return nil, fmt.Errorf("constant term of f must not be 0")
+	}
+	g := []int{ModPow((f[0]%NTTMod+NTTMod)%NTTMod, NTTMod-2, NTTMod)}
+	for m := 1; m < n; m <<= 1 {
+		// g <- g(2 - fg)
+		fg := fpsMul(fpsPrefix(f, 2*m), g, 2*m)
+		for i := range fg {
+			fg[i] = (NTTMod - fg[i]) % NTTMod
+		}
+		fg[0] = (fg[0] + 2) % NTTMod
+		g = fpsMul(g, fg, 2*m)
+	}
+	return fpsPrefix(g, n), nil
+}
+
+// FPSDifferential は、fを微分した多項式を返します.
+func FPSDifferential(f []int) []int {
+	if len(f) <= 1 {
+		return nil
+	}
+	res := make([]int, len(f)-1)
+	for i := 1; i < len(f); i++ {
+		res[i-1] = (f[i]%NTTMod + NTTMod) % NTTMod * i % NTTMod
+	}
+	return res
+}
+
+// FPSIntegral は、fを積分した多項式を返します. 定数項は0です.
+func FPSIntegral(f []int) []int {
+	res := make([]int, len(f)+1)
+	if len(f) == 0 {
+		return res
+	}
+	inv := make([]int, len(f)+1)
+	inv[1] = 1
+	for i := 2; i <= len(f); i++ {
+		inv[i] = NTTMod - NTTMod/i*inv[NTTMod%i]%NTTMod
+	}
+	for i, v := range f {
+		res[i+1] = (v%NTTMod + NTTMod) % NTTMod * inv[i+1] % NTTMod
+	}
+	return res
+}
+
+// FPSLog は、log fの先頭n項を返します. f[0]が1でない場合はエラーを返します. 計算量はO(n log n)です.
+func FPSLog(f []int, n int) ([]int, error) {
+	if err := validateFPSLength(n); err != nil {
+		return nil, err
+	}
+	if len(f) == 0 || (f[0]%NTTMod+NTTMod)%NTTMod != 1 {
+		return nil, fmt.Errorf("constant term of f must be 1")
+	}
+	if n == 0 {
+		return []int{}, nil
+	}
+	inv, err := FPSInv(f, n)
+	if err != nil {
+		return nil, err
+	}
+	df := FPSDifferential(fpsPrefix(f, n))
+	return fpsPrefix(FPSIntegral(fpsMul(df, inv, n-1)), n), nil
+}
+
+// FPSExp は、exp fの先頭n項を返します. f[0]が0でない場合はエラーを返します.
+// ニュートン法により、計算量はO(n log n)です.
+func FPSExp(f []int, n int) ([]int, error) {
+	if err := validateFPSLength(n); err != nil {
+		return nil, err
+	}
+	if len(f) > 0 && f[0]%NTTMod != 0 {
+		return nil, fmt.Errorf("constant term of f must be 0")
+	}
+	if n == 0 {
+		return []int{}, nil
+	}
+	g := []int{1}
+	for m := 1; m < n; m <<= 1 {
+		// g <- g(1 - log g + f)
+		logG, err := FPSLog(g, 2*m)
+		if err != nil {
+			return nil, err
+		}
+		h := fpsPrefix(f, 2*m)
+		for i := range h {
+			h[i] = (h[i] - logG[i] + NTTMod) % NTTMod
+		}
+		h[0] = (h[0] + 1) % NTTMod
+		g = fpsMul(g, h, 2*m)
+	}
+	return fpsPrefix(g, n), nil
+}
+
+// FPSPow は、fのk乗の先頭n項を返します. kは0以上である必要があります. 計算量はO(n log n)です.
+func FPSPow(f []int, k, n int) ([]int, error) {
+	if err := validateFPSLength(n); err != nil {
+		return nil, err
+	}
+	if k < 0 {
+		return nil, fmt.Errorf("negative k is given: %d", k)
+	}
+	res := make([]int, n)
+	if k == 0 {
+		if n > 0 {
+			res[0] = 1
+		}
+		return res, nil
+	}
+
+	f = fpsPrefix(f, MustMinInt(len(f), n))
+	l := 0
+	for l < len(f) && f[l] == 0 {
+		l++
+	}
+	// 最低次の項x^lについて、l*k >= nであれば全ての係数が0になる
+	if l == len(f) || (l > 0 && k >= (n+l-1)/l) {
+		return res, nil
+	}
+
+	// f = c * x^l * (1 + g)として、f^k = c^k * x^(lk) * exp(k log(1 + g))を求める
+	c := f[l]
+	cInv := ModPow(c, NTTMod-2, NTTMod)
+	g := make([]int, len(f)-l)
+	for i := range g {
+		g[i] = f[l+i] * cInv % NTTMod
+	}
+	m := n - l*k
+	logG, err := FPSLog(g, m)
+	if err != nil {
+		return nil, err
+	}
+	kMod := k % NTTMod
+	for i := range logG {
+		logG[i] = logG[i] * kMod % NTTMod
+	}
+	expG, err := FPSExp(logG, m)
+	if err != nil {
+		return nil, err
+	}
+	ck := ModPow(c, k, NTTMod)
+	for i, v := range expG {
+		res[l*k+i] = v * ck % NTTMod
+	}
+	return res, nil
+}
@@ -0,0 +1,431 @@
+package lib
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// naiveConvolution は、c[k] = Σ_{i+j=k} a[i]*b[j]をmodで割ったあまりを愚直に計算します. modが0の場合は剰余を取りません.
+func naiveConvolution(a, b []int, mod int) []int {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	res := make([]int, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			if mod == 0 {
+				res[i+j] += x * y
+				continue
+			}
+			res[i+j] = (res[i+j] + ModMul((x%mod+mod)%mod, (y%mod+mod)%mod, mod)) % mod
+		}
+	}
+	return res
+}
+
+func randomIntSlice(r *rand.Rand, n, min, max int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = min + r.Intn(max-min)
+	}
+	return res
+}
+
+func TestConvolution(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       []int
+		b       []int
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "Convolution",
+			a:    []int{1, 2, 3, 4},
+			b:    []int{5, 6, 7, 8, 9},
+			want: []int{5, 16, 34, 60, 70, 70, 59, 36},
+		},
+		{
+			name: "Convolution",
+			a:    []int{-1, NTTMod + 1},
+			b:    []int{1, 1},
+			want: []int{NTTMod - 1, 0, 1},
+		},
+		{
+			name: "Convolution",
+			a:    []int{},
+			b:    []int{1, 2},
+			want: nil,
+		},
+		{
+			name:    "Convolution",
+			a:       make([]int, 1<<22+1),
+			b:       make([]int, 1<<22+1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convolution(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Convolution() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Convolution() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		a := randomIntSlice(r, 1+r.Intn(300), 0, NTTMod)
+		b := randomIntSlice(r, 1+r.Intn(300), 0, NTTMod)
+		if got, want := MustConvolution(a, b), naiveConvolution(a, b, NTTMod); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Convolution(%v, %v) = %v, want %v", a, b, got, want)
+		}
+	}
+}
+
+func TestConvolutionMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       []int
+		b       []int
+		mod     int
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "ConvolutionMod",
+			a:    []int{1000000006, 2},
+			b:    []int{1000000006, 3},
+			mod:  1000000007,
+			want: []int{1, 1000000002, 6},
+		},
+		{
+			// 積の和がintの範囲を超える
+			name: "ConvolutionMod",
+			a:    NewIntSliceWithInitialValue(10, 1000000006),
+			b:    NewIntSliceWithInitialValue(10, 1000000006),
+			mod:  1000000007,
+			want: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+		},
+		{
+			name: "ConvolutionMod",
+			a:    []int{-1, 8},
+			b:    []int{3},
+			mod:  7,
+			want: []int{4, 3},
+		},
+		{
+			name: "ConvolutionMod",
+			a:    []int{1<<31 - 1, 1 << 30},
+			b:    []int{1<<31 - 1},
+			mod:  1 << 31,
+			want: []int{1, 1 << 30},
+		},
+		{
+			name: "ConvolutionMod",
+			a:    []int{5, 6},
+			b:    []int{7},
+			mod:  1,
+			want: []int{0, 0},
+		},
+		{
+			name:    "ConvolutionMod",
+			a:       []int{1},
+			b:       []int{1},
+			mod:     0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvolutionMod(tt.a, tt.b, tt.mod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ConvolutionMod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvolutionMod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// convolutionNaiveThresholdより長い列で、3つのNTT素数とGarnerのアルゴリズムによる計算を確かめる
+	r := rand.New(rand.NewSource(1))
+	for _, mod := range []int{1, 2, 1000000007, 1 << 31} {
+		for i := 0; i < 5; i++ {
+			a := randomIntSlice(r, convolutionNaiveThreshold+1+r.Intn(240), -mod, mod)
+			b := randomIntSlice(r, convolutionNaiveThreshold+1+r.Intn(240), -mod, mod)
+			if got, want := MustConvolutionMod(a, b, mod), naiveConvolution(a, b, mod); !reflect.DeepEqual(got, want) {
+				t.Fatalf("ConvolutionMod(%v, %v, %d) = %v, want %v", a, b, mod, got, want)
+			}
+		}
+	}
+}
+
+func TestConvolutionInt(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int
+		b    []int
+		want []int
+	}{
+		{
+			name: "ConvolutionInt",
+			a:    []int{1, -2, 3},
+			b:    []int{-4, 5},
+			want: []int{-4, 13, -22, 15},
+		},
+		{
+			name: "ConvolutionInt",
+			a:    []int{200000000, -200000000},
+			b:    []int{200000000, 200000000},
+			want: []int{40000000000000000, 0, -40000000000000000},
+		},
+		{
+			// NTTModを超える係数
+			name: "ConvolutionInt",
+			a:    []int{200000000, 200000000, 200000000},
+			b:    []int{200000000, 200000000, 200000000},
+			want: []int{40000000000000000, 80000000000000000, 120000000000000000, 80000000000000000, 40000000000000000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MustConvolutionInt(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvolutionInt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, max := range []int{10, 1000000, 200000000} {
+		for i := 0; i < 5; i++ {
+			a := randomIntSlice(r, convolutionNaiveThreshold+1+r.Intn(40), -max, max)
+			b := randomIntSlice(r, convolutionNaiveThreshold+1+r.Intn(40), -max, max)
+			if got, want := MustConvolutionInt(a, b), naiveConvolution(a, b, 0); !reflect.DeepEqual(got, want) {
+				t.Fatalf("ConvolutionInt(%v, %v) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestFPSInv(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       []int
+		n       int
+		want    []int
+		wantErr bool
+	}{
+		{
+			// 1/(1-x) = 1 + x + x^2 + ...
+			name: "FPSInv",
+			f:    []int{1, -1},
+			n:    5,
+			want: []int{1, 1, 1, 1, 1},
+		},
+		{
+			// 1/(1+x) = 1 - x + x^2 - ...
+			name: "FPSInv",
+			f:    []int{1, 1},
+			n:    4,
+			want: []int{1, NTTMod - 1, 1, NTTMod - 1},
+		},
+		{
+			// ニュートン法の反復で長さが2の冪を超える
+			name: "FPSInv",
+			f:    []int{1, -1},
+			n:    70,
+			want: NewIntSliceWithInitialValue(70, 1),
+		},
+		{
+			name: "FPSInv",
+			f:    []int{2},
+			n:    3,
+			want: []int{(NTTMod + 1) / 2, 0, 0},
+		},
+		{
+			name:    "FPSInv",
+			f:       []int{0, 1},
+			n:       3,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FPSInv(tt.f, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FPSInv() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FPSInv() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFPSLog(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       []int
+		n       int
+		want    []int
+		wantErr bool
+	}{
+		{
+			// log(1/(1-x)) = Σ x^k/k
+			name: "FPSLog",
+			f:    []int{1, 1, 1, 1, 1, 1},
+			n:    6,
+			want: []int{0, 1, 499122177, 332748118, 748683265, 598946612},
+		},
+		{
+			name: "FPSLog",
+			f:    []int{1},
+			n:    3,
+			want: []int{0, 0, 0},
+		},
+		{
+			name:    "FPSLog",
+			f:       []int{2, 1},
+			n:       3,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FPSLog(tt.f, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FPSLog() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FPSLog() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFPSExp(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       []int
+		n       int
+		want    []int
+		wantErr bool
+	}{
+		{
+			// exp(x) = Σ x^k/k!
+			name: "FPSExp",
+			f:    []int{0, 1},
+			n:    6,
+			want: []int{1, 1, 499122177, 166374059, 291154603, 856826403},
+		},
+		{
+			// exp(Σ x^k/k) = 1/(1-x). ニュートン法の反復で長さが2の冪を超える
+			name: "FPSExp",
+			f:    MustFPSLog(NewIntSliceWithInitialValue(70, 1), 70),
+			n:    70,
+			want: NewIntSliceWithInitialValue(70, 1),
+		},
+		{
+			name:    "FPSExp",
+			f:       []int{1, 1},
+			n:       3,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FPSExp(tt.f, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FPSExp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FPSExp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFPSPow(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       []int
+		k       int
+		n       int
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "FPSPow",
+			f:    []int{1, 1},
+			k:    4,
+			n:    7,
+			want: []int{1, 4, 6, 4, 1, 0, 0},
+		},
+		{
+			name: "FPSPow",
+			f:    []int{0, 0, 1, 1},
+			k:    3,
+			n:    10,
+			want: []int{0, 0, 0, 0, 0, 0, 1, 3, 3, 1},
+		},
+		{
+			// (1-x)^3
+			name: "FPSPow",
+			f:    []int{1, NTTMod - 1},
+			k:    3,
+			n:    5,
+			want: []int{1, NTTMod - 3, 3, NTTMod - 1, 0},
+		},
+		{
+			name: "FPSPow",
+			f:    []int{3},
+			k:    2,
+			n:    2,
+			want: []int{9, 0},
+		},
+		{
+			name: "FPSPow",
+			f:    []int{0, 5},
+			k:    1000000000000000000,
+			n:    5,
+			want: []int{0, 0, 0, 0, 0},
+		},
+		{
+			name: "FPSPow",
+			f:    []int{0, 0},
+			k:    0,
+			n:    3,
+			want: []int{1, 0, 0},
+		},
+		{
+			name:    "FPSPow",
+			f:       []int{1},
+			k:       -1,
+			n:       3,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FPSPow(tt.f, tt.k, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FPSPow() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FPSPow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+}
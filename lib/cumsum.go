@@ -0,0 +1,145 @@
+package lib
+
+import "fmt"
+
+// CumSumAAA は、1次元の累積和です. 構築後は任意の区間の和をO(1)で返します.
+type CumSumAAA struct {
+	// Prefix は、Prefix[i]が先頭i要素の和となるsliceです. 長さは元のsliceの長さ+1です.
+	Prefix []AAA
+}
+
+// NewCumSumAAA は、valuesの累積和を構築します. 計算量はO(N)です.
+func NewCumSumAAA(values []AAA) *CumSumAAA {
+	prefix := make([]AAA, len(values)+1)
+	for i, v := range values {
+		prefix[i+1] = prefix[i] + v
+	}
+	return &CumSumAAA{Prefix: prefix}
+}
+
+// Sum は、半開区間[l, r)の要素の和を返します.
+func (c *CumSumAAA) Sum(l, r int) AAA {
+	return c.Prefix[r] - c.Prefix[l]
+}
+
+// CumSum2DAAA は、2次元の累積和です. 構築後は任意の長方形領域の和をO(1)で返します.
+type CumSum2DAAA struct {
+	// Prefix は、Prefix[i][j]が[0, i)行[0, j)列の要素の和となる二次元sliceです.
+	Prefix [][]AAA
+}
+
+// NewCumSum2DAAA は、gridの2次元累積和を構築します. 各行の長さが異なる場合はエラーを返します. 計算量はO(HW)です.
+func NewCumSum2DAAA(grid [][]AAA) (*CumSum2DAAA, error) {
+	w := 0
+	if len(grid) > 0 {
+		w = len(grid[0])
+	}
+	prefix := make([][]AAA, len(grid)+1)
+	prefix[0] = make([]AAA, w+1)
+	for i, line := range grid {
+		if len(line) != w {
+			return nil, fmt.Errorf("%dth line length(%d) is different from first line length(%d)", i, len(line), w)
+		}
+		prefix[i+1] = make([]AAA, w+1)
+		for j, v := range line {
+			prefix[i+1][j+1] = prefix[i][j+1] + prefix[i+1][j] - prefix[i][j] + v
+		}
+	}
+	return &CumSum2DAAA{Prefix: prefix}, nil
+}
+
+// Sum は、[r1, r2)行[c1, c2)列の長方形領域の要素の和を返します.
+func (c *CumSum2DAAA) Sum(r1, c1, r2, c2 int) AAA {
+	return c.Prefix[r2][c2] - c.Prefix[r1][c2] - c.Prefix[r2][c1] + c.Prefix[r1][c1]
+}
+
+// ImosAAA は、1次元のいもす法(差分配列)です. 区間への加算をO(1)で記録し、最後にBuildでまとめて各要素の値を求めます.
+type ImosAAA struct {
+	diff []AAA
+}
+
+// NewImosAAA は、長さnの全ての要素が0の配列に対するいもす法を返します.
+func NewImosAAA(n int) *ImosAAA {
+	return &ImosAAA{diff: make([]AAA, n+1)}
+}
+
+// Add は、半開区間[l, r)の各要素にvを加算します.
+func (im *ImosAAA) Add(l, r int, v AAA) {
+	im.diff[l] += v
+	im.diff[r] -= v
+}
+
+// Build は、それまでのAddを反映した長さnのsliceを返します. 計算量はO(N)です.
+func (im *ImosAAA) Build() []AAA {
+	res := make([]AAA, len(im.diff)-1)
+	var cur AAA
+	for i := range res {
+		cur += im.diff[i]
+		res[i] = cur
+	}
+	return res
+}
+
+// Imos2DAAA は、2次元のいもす法です. 長方形領域への加算をO(1)で記録し、最後にBuildでまとめて各要素の値を求めます.
+type Imos2DAAA struct {
+	diff [][]AAA
+}
+
+// NewImos2DAAA は、h行w列の全ての要素が0の二次元配列に対するいもす法を返します.
+func NewImos2DAAA(h, w int) *Imos2DAAA {
+	diff := make([][]AAA, h+1)
+	for i := range diff {
+		diff[i] = make([]AAA, w+1)
+	}
+	return &Imos2DAAA{diff: diff}
+}
+
+// Add は、[r1, r2)行[c1, c2)列の長方形領域の各要素にvを加算します.
+func (im *Imos2DAAA) Add(r1, c1, r2, c2 int, v AAA) {
+	im.diff[r1][c1] += v
+	im.diff[r1][c2] -= v
+	im.diff[r2][c1] -= v
+	im.diff[r2][c2] += v
+}
+
+// Build は、それまでのAddを反映したh行w列の二次元sliceを返します. 計算量はO(HW)です.
+func (im *Imos2DAAA) Build() [][]AAA {
+	h, w := len(im.diff)-1, len(im.diff[0])-1
+	res := make([][]AAA, h)
+	for i := range res {
+		res[i] = make([]AAA, w)
+		for j := range res[i] {
+			res[i][j] = im.diff[i][j]
+			if i > 0 {
+				res[i][j] += res[i-1][j]
+			}
+			if j > 0 {
+				res[i][j] += res[i][j-1]
+			}
+			if i > 0 && j > 0 {
+				res[i][j] -= res[i-1][j-1]
+			}
+		}
+	}
+	return res
+}
+
+// CumProdAAA は、1次元の区間積です. 割り算を使わずにDisjoint Sparse Tableで区間の積を求めるため、
+// 0を含む場合や、区間外の要素まで含めた積がオーバーフローする場合も、任意の区間の積をO(1)で返します.
+type CumProdAAA struct {
+	table *DisjointSparseTableAAA
+}
+
+// NewCumProdAAA は、valuesの区間積を構築します. 計算量はO(N log N)です.
+func NewCumProdAAA(values []AAA) *CumProdAAA {
+	return &CumProdAAA{table: NewDisjointSparseTableAAA(values, func(a, b AAA) AAA { return a * b })}
+}
+
+// Prod は、半開区間[l, r)の要素の積を返します. 空区間の場合は1を返します.
+// 整数型では、区間の積がオーバーフローしない必要があります.
+func (c *CumProdAAA) Prod(l, r int) AAA {
+	if l == r {
+		return 1
+	}
+	return c.table.Query(l, r)
+}
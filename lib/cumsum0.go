@@ -0,0 +1,21 @@
+package lib
+
+// CumXor は、1次元の累積xorです. 構築後は任意の区間のxorをO(1)で返します.
+type CumXor struct {
+	// Prefix は、Prefix[i]が先頭i要素のxorとなるsliceです.
+	Prefix []int
+}
+
+// NewCumXor は、valuesの累積xorを構築します. 計算量はO(N)です.
+func NewCumXor(values []int) *CumXor {
+	prefix := make([]int, len(values)+1)
+	for i, v := range values {
+		prefix[i+1] = prefix[i] ^ v
+	}
+	return &CumXor{Prefix: prefix}
+}
+
+// Xor は、半開区間[l, r)の要素のxorを返します.
+func (c *CumXor) Xor(l, r int) int {
+	return c.Prefix[r] ^ c.Prefix[l]
+}
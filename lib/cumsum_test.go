@@ -0,0 +1,137 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCumSumAAA(t *testing.T) {
+	values := []AAA{3, -1, 4, 1, -5, 9}
+	tests := []struct {
+		name string
+		l, r int
+		want AAA
+	}{
+		{name: "CumSumAAA", l: 0, r: 6, want: 11},
+		{name: "CumSumAAA", l: 1, r: 3, want: 3},
+		{name: "CumSumAAA", l: 0, r: 1, want: 3},
+		{name: "CumSumAAA", l: 4, r: 6, want: 4},
+		{name: "CumSumAAA", l: 2, r: 2, want: 0},
+	}
+	c := NewCumSumAAA(values)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Sum(tt.l, tt.r); got != tt.want {
+				t.Errorf("CumSumAAA.Sum(%d, %d) = %v, want %v", tt.l, tt.r, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCumSum2DAAA(t *testing.T) {
+	grid := [][]AAA{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	tests := []struct {
+		name           string
+		r1, c1, r2, c2 int
+		want           AAA
+	}{
+		{name: "CumSum2DAAA", r1: 0, c1: 0, r2: 3, c2: 3, want: 45},
+		{name: "CumSum2DAAA", r1: 1, c1: 1, r2: 3, c2: 3, want: 28},
+		{name: "CumSum2DAAA", r1: 0, c1: 1, r2: 2, c2: 2, want: 7},
+		{name: "CumSum2DAAA", r1: 0, c1: 2, r2: 3, c2: 3, want: 18},
+		{name: "CumSum2DAAA", r1: 2, c1: 0, r2: 2, c2: 3, want: 0},
+	}
+	c := MustNewCumSum2DAAA(grid)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Sum(tt.r1, tt.c1, tt.r2, tt.c2); got != tt.want {
+				t.Errorf("CumSum2DAAA.Sum(%d, %d, %d, %d) = %v, want %v", tt.r1, tt.c1, tt.r2, tt.c2, got, tt.want)
+			}
+		})
+	}
+	if _, err := NewCumSum2DAAA([][]AAA{{1, 2}, {3}}); err == nil {
+		t.Errorf("NewCumSum2DAAA() error = nil, want error")
+	}
+}
+
+func TestImosAAA(t *testing.T) {
+	im := NewImosAAA(6)
+	im.Add(0, 3, 1)
+	im.Add(2, 6, 2)
+	im.Add(5, 6, -4)
+	if got, want := im.Build(), []AAA{1, 1, 3, 2, 2, -2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ImosAAA.Build() = %v, want %v", got, want)
+	}
+}
+
+func TestImos2DAAA(t *testing.T) {
+	im := NewImos2DAAA(3, 4)
+	im.Add(0, 0, 2, 2, 1)
+	im.Add(1, 1, 3, 4, 2)
+	im.Add(0, 3, 1, 4, 5)
+	// 空の領域への加算は何もしない
+	im.Add(1, 2, 1, 4, 7)
+	want := [][]AAA{
+		{1, 1, 0, 5},
+		{1, 3, 2, 2},
+		{0, 2, 2, 2},
+	}
+	if got := im.Build(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Imos2DAAA.Build() = %v, want %v", got, want)
+	}
+}
+
+func TestCumProdAAA(t *testing.T) {
+	// 2が100個並んだ列. 全体の積は2^100になる
+	twos := make([]AAA, 100)
+	for i := range twos {
+		twos[i] = 2
+	}
+	tests := []struct {
+		name   string
+		values []AAA
+		l, r   int
+		want   AAA
+	}{
+		{name: "0を含まない区間", values: []AAA{2, 3, 0, 5, -1, 4}, l: 0, r: 2, want: 6},
+		{name: "0を含む区間", values: []AAA{2, 3, 0, 5, -1, 4}, l: 1, r: 4, want: 0},
+		{name: "0のみの区間", values: []AAA{2, 3, 0, 5, -1, 4}, l: 2, r: 3, want: 0},
+		{name: "0より後ろの区間", values: []AAA{2, 3, 0, 5, -1, 4}, l: 3, r: 6, want: -20},
+		{name: "空区間", values: []AAA{2, 3, 0, 5, -1, 4}, l: 2, r: 2, want: 1},
+		{name: "0が連続する列", values: []AAA{0, 0, 7}, l: 2, r: 3, want: 7},
+		{name: "2が並ぶ列の末尾", values: twos, l: 90, r: 100, want: 1 << 10},
+		{name: "2が並ぶ列の先頭", values: twos, l: 0, r: 62, want: 1 << 62},
+		{name: "2が並ぶ列の途中", values: twos, l: 37, r: 99, want: 1 << 62},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewCumProdAAA(tt.values).Prod(tt.l, tt.r); got != tt.want {
+				t.Errorf("CumProdAAA.Prod(%d, %d) = %v, want %v", tt.l, tt.r, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCumXor(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		l, r   int
+		want   int
+	}{
+		{name: "CumXor", values: []int{5, 3, 8, 1, 6}, l: 0, r: 5, want: 9},
+		{name: "CumXor", values: []int{5, 3, 8, 1, 6}, l: 1, r: 3, want: 11},
+		{name: "CumXor", values: []int{5, 3, 8, 1, 6}, l: 3, r: 5, want: 7},
+		{name: "CumXor", values: []int{5, 3, 8, 1, 6}, l: 2, r: 2, want: 0},
+		// float64を経由すると2^53を超える値の下位のビットが失われる
+		{name: "CumXor", values: []int{1<<62 | 1, 1 << 62}, l: 0, r: 2, want: 1},
+		{name: "CumXor", values: []int{-1, 5}, l: 0, r: 2, want: -6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewCumXor(tt.values).Xor(tt.l, tt.r); got != tt.want {
+				t.Errorf("CumXor.Xor(%d, %d) = %v, want %v", tt.l, tt.r, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package lib
+
+// DequeZZZ は、リングバッファによる両端キューです. 両端への追加と削除を償却O(1)、任意の位置の参照をO(1)で行えます.
+// ゼロ値は空の両端キューとして利用できます. 空の場合のPopやFront、範囲外のAtはpanicします.
+type DequeZZZ struct {
+	buf  []ZZZ
+	head int
+	size int
+}
+
+// NewDequeZZZ は、valuesを先頭から順に格納した両端キューを返します.
+func NewDequeZZZ(values ...ZZZ) *DequeZZZ {
+	d := &DequeZZZ{buf: make([]ZZZ, len(values))}
+	copy(d.buf, values)
+	d.size = len(values)
+	return d
+}
+
+// Len は、要素数を返します.
+func (d *DequeZZZ) Len() int {
+	return d.size
+}
+
+// grow は、要素を1つ追加できるようにバッファを拡張します.
+func (d *DequeZZZ) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+	newBuf := make([]ZZZ, 2*len(d.buf)+1)
+	n := copy(newBuf, d.buf[d.head:])
+	copy(newBuf[n:], d.buf[:d.head])
+	d.buf, d.head = newBuf, 0
+}
+
+// index は、先頭からi番目の要素のバッファ上の位置を返します.
+func (d *DequeZZZ) index(i int) int {
+	i += d.head
+	if i >= len(d.buf) {
+		i -= len(d.buf)
+	}
+	return i
+}
+
+// PushBack は、末尾にvを追加します.
+func (d *DequeZZZ) PushBack(v ZZZ) {
+	d.grow()
+	d.buf[d.index(d.size)] = v
+	d.size++
+}
+
+// PushFront は、先頭にvを追加します.
+func (d *DequeZZZ) PushFront(v ZZZ) {
+	d.grow()
+	d.head--
+	if d.head < 0 {
+		d.head += len(d.buf)
+	}
+	d.buf[d.head] = v
+	d.size++
+}
+
+// PopFront は、先頭の要素を取り除いて返します.
+func (d *DequeZZZ) PopFront() ZZZ {
+	if d.size == 0 {
+		panic("PopFront is called on empty deque")
+	}
+	var zero ZZZ
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return v
+}
+
+// PopBack は、末尾の要素を取り除いて返します.
+func (d *DequeZZZ) PopBack() ZZZ {
+	if d.size == 0 {
+		panic("PopBack is called on empty deque")
+	}
+	var zero ZZZ
+	i := d.index(d.size - 1)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+	return v
+}
+
+// At は、先頭からi番目(0始まり)の要素を返します.
+func (d *DequeZZZ) At(i int) ZZZ {
+	if i < 0 || i >= d.size {
+		panic("index out of range")
+	}
+	return d.buf[d.index(i)]
+}
+
+// Set は、先頭からi番目(0始まり)の要素をvにします.
+func (d *DequeZZZ) Set(i int, v ZZZ) {
+	if i < 0 || i >= d.size {
+		panic("index out of range")
+	}
+	d.buf[d.index(i)] = v
+}
+
+// Front は、先頭の要素を返します.
+func (d *DequeZZZ) Front() ZZZ {
+	return d.At(0)
+}
+
+// Back は、末尾の要素を返します.
+func (d *DequeZZZ) Back() ZZZ {
+	return d.At(d.size - 1)
+}
+
+// Values は、全ての要素を先頭から順に並べたsliceを返します.
+func (d *DequeZZZ) Values() []ZZZ {
+	values := make([]ZZZ, d.size)
+	for i := range values {
+		values[i] = d.buf[d.index(i)]
+	}
+	return values
+}
+
+// QueueZZZ は、先入れ先出しのキューです. `q = q[1:]`による実装と異なり、取り出した要素の領域は再利用されます.
+// ゼロ値は空のキューとして利用できます. 空の場合のPopやFrontはpanicします.
+type QueueZZZ struct {
+	d DequeZZZ
+}
+
+// NewQueueZZZ は、valuesを先頭から順に格納したキューを返します.
+func NewQueueZZZ(values ...ZZZ) *QueueZZZ {
+	return &QueueZZZ{d: *NewDequeZZZ(values...)}
+}
+
+// Len は、要素数を返します.
+func (q *QueueZZZ) Len() int {
+	return q.d.Len()
+}
+
+// Push は、末尾にvを追加します.
+func (q *QueueZZZ) Push(v ZZZ) {
+	q.d.PushBack(v)
+}
+
+// Pop は、先頭の要素を取り除いて返します.
+func (q *QueueZZZ) Pop() ZZZ {
+	return q.d.PopFront()
+}
+
+// Front は、先頭の要素を返します.
+func (q *QueueZZZ) Front() ZZZ {
+	return q.d.Front()
+}
+
+// StackZZZ は、後入れ先出しのスタックです.
+// ゼロ値は空のスタックとして利用できます. 空の場合のPopやTopはpanicします.
+type StackZZZ struct {
+	data []ZZZ
+}
+
+// NewStackZZZ は、valuesを順にPushしたスタックを返します.
+func NewStackZZZ(values ...ZZZ) *StackZZZ {
+	return &StackZZZ{data: append([]ZZZ{}, values...)}
+}
+
+// Len は、要素数を返します.
+func (s *StackZZZ) Len() int {
+	return len(s.data)
+}
+
+// Push は、vを追加します.
+func (s *StackZZZ) Push(v ZZZ) {
+	s.data = append(s.data, v)
+}
+
+// Pop は、最後に追加された要素を取り除いて返します.
+func (s *StackZZZ) Pop() ZZZ {
+	if len(s.data) == 0 {
+		panic("Pop is called on empty stack")
+	}
+	var zero ZZZ
+	last := len(s.data) - 1
+	v := s.data[last]
+	s.data[last] = zero
+	s.data = s.data[:last]
+	return v
+}
+
+// Top は、最後に追加された要素を返します.
+func (s *StackZZZ) Top() ZZZ {
+	return s.data[len(s.data)-1]
+}
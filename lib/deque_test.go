@@ -0,0 +1,133 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDequeZZZ(t *testing.T) {
+	type op struct {
+		name string
+		v    ZZZ
+	}
+	tests := []struct {
+		name       string
+		values     []ZZZ
+		ops        []op
+		wantPopped []ZZZ
+		want       []ZZZ
+	}{
+		{
+			name:   "末尾に追加",
+			values: []ZZZ{1, 2, 3},
+			ops:    []op{{"PushBack", 4}, {"PushBack", 5}},
+			want:   []ZZZ{1, 2, 3, 4, 5},
+		},
+		{
+			name: "空から両端に追加",
+			ops:  []op{{"PushFront", 2}, {"PushFront", 1}, {"PushBack", 3}},
+			want: []ZZZ{1, 2, 3},
+		},
+		{
+			name:       "バッファを一周してから拡張する",
+			values:     []ZZZ{1, 2, 3},
+			ops:        []op{{"PopFront", nil}, {"PushBack", 4}, {"PushFront", 0}, {"PushFront", -1}},
+			wantPopped: []ZZZ{1},
+			want:       []ZZZ{-1, 0, 2, 3, 4},
+		},
+		{
+			name:       "両端から取り出す",
+			values:     []ZZZ{1, 2, 3, 4},
+			ops:        []op{{"PopBack", nil}, {"PopFront", nil}, {"PopBack", nil}, {"PushFront", 0}},
+			wantPopped: []ZZZ{4, 1, 3},
+			want:       []ZZZ{0, 2},
+		},
+		{
+			name:       "全て取り出した後に追加",
+			values:     []ZZZ{1, 2},
+			ops:        []op{{"PopFront", nil}, {"PopBack", nil}, {"PushBack", 3}},
+			wantPopped: []ZZZ{1, 2},
+			want:       []ZZZ{3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDequeZZZ(tt.values...)
+			var popped []ZZZ
+			for _, o := range tt.ops {
+				switch o.name {
+				case "PushBack":
+					d.PushBack(o.v)
+				case "PushFront":
+					d.PushFront(o.v)
+				case "PopBack":
+					popped = append(popped, d.PopBack())
+				case "PopFront":
+					popped = append(popped, d.PopFront())
+				}
+			}
+			if !reflect.DeepEqual(popped, tt.wantPopped) {
+				t.Errorf("popped = %v, want %v", popped, tt.wantPopped)
+			}
+			if got := d.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Values() = %v, want %v", got, tt.want)
+			}
+			if d.Len() != len(tt.want) {
+				t.Errorf("Len() = %d, want %d", d.Len(), len(tt.want))
+			}
+			for i, w := range tt.want {
+				if got := d.At(i); got != w {
+					t.Errorf("At(%d) = %v, want %v", i, got, w)
+				}
+			}
+		})
+	}
+}
+
+func TestDequeInt_ZeroValue(t *testing.T) {
+	var d DequeInt
+	d.PushFront(2)
+	d.PushFront(1)
+	d.PushBack(3)
+	d.Set(1, 5)
+	if got, want := d.Values(), []int{1, 5, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if d.Front() != 1 || d.Back() != 3 {
+		t.Errorf("(Front(), Back()) = (%d, %d), want (1, 3)", d.Front(), d.Back())
+	}
+}
+
+func TestQueueInt(t *testing.T) {
+	q := NewQueueInt(1, 2)
+	q.Push(3)
+	var got []int
+	for q.Len() > 0 {
+		f, v := q.Front(), q.Pop()
+		if f != v {
+			t.Fatalf("Front() = %d, but Pop() = %d", f, v)
+		}
+		got = append(got, v)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got = %v, want %v", got, want)
+	}
+}
+
+func TestStackString(t *testing.T) {
+	var s StackString
+	for _, v := range []string{"a", "b", "c"} {
+		s.Push(v)
+	}
+	var got []string
+	for s.Len() > 0 {
+		top, v := s.Top(), s.Pop()
+		if top != v {
+			t.Fatalf("Top() = %s, but Pop() = %s", top, v)
+		}
+		got = append(got, v)
+	}
+	if want := []string{"c", "b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,351 @@
+package lib
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+const flowInf = int(^uint(0) >> 1)
+
+// FlowEdge は、フローネットワークの辺の状態を表します.
+type FlowEdge struct {
+	From, To  int
+	Cap, Flow int
+	Cost      int
+}
+
+type flowEdge struct {
+	to, rev, cap, cost int
+}
+
+type flowGraph struct {
+	n   int
+	g   [][]flowEdge
+	pos [][2]int
+}
+
+func newFlowGraph(nodeNum int) (*flowGraph, error) {
+	if nodeNum < 1 {
+		return nil, fmt.Errorf("invalid nodeNum: %d", nodeNum)
+	}
+	return &flowGraph{n: nodeNum, g: make([][]flowEdge, nodeNum)}, nil
+}
+
+func (f *flowGraph) validateNode(v int) error {
+	if v < 0 || v >= f.n {
+		return fmt.Errorf("node(%d) is out of range [0, %d)", v, f.n)
+	}
+	return nil
+}
+
+func (f *flowGraph) validateTerminals(s, t int) error {
+	if err := f.validateNode(s); err != nil {
+		return fmt.Errorf("invalid source: %v", err)
+	}
+	if err := f.validateNode(t); err != nil {
+		return fmt.Errorf("invalid sink: %v", err)
+	}
+	if s == t {
+		return fmt.Errorf("source and sink are same node: %d", s)
+	}
+	return nil
+}
+
+func (f *flowGraph) addEdge(from, to, cap, cost int) (int, error) {
+	if err := f.validateNode(from); err != nil {
+		return 0, fmt.Errorf("invalid from: %v", err)
+	}
+	if err := f.validateNode(to); err != nil {
+		return 0, fmt.Errorf("invalid to: %v", err)
+	}
+	if cap < 0 {
+		return 0, fmt.Errorf("negative capacity is given: %d", cap)
+	}
+	fromIndex, toIndex := len(f.g[from]), len(f.g[to])
+	if from == to {
+		toIndex++
+	}
+	f.pos = append(f.pos, [2]int{from, fromIndex})
+	f.g[from] = append(f.g[from], flowEdge{to: to, rev: toIndex, cap: cap, cost: cost})
+	f.g[to] = append(f.g[to], flowEdge{to: from, rev: fromIndex, cap: 0, cost: -cost})
+	return len(f.pos) - 1, nil
+}
+
+func (f *flowGraph) edge(i int) FlowEdge {
+	p := f.pos[i]
+	e := f.g[p[0]][p[1]]
+	re := f.g[e.to][e.rev]
+	return FlowEdge{From: p[0], To: e.to, Cap: e.cap + re.cap, Flow: re.cap, Cost: e.cost}
+}
+
+func (f *flowGraph) edges() []FlowEdge {
+	es := make([]FlowEdge, len(f.pos))
+	for i := range f.pos {
+		es[i] = f.edge(i)
+	}
+	return es
+}
+
+// FlowNetwork は、最大流を求めるためのフローネットワークです.
+type FlowNetwork struct {
+	*flowGraph
+	level []int
+	iter  []int
+}
+
+// NewFlowNetwork は、nodeNum頂点からなる辺のないフローネットワークを返します.
+func NewFlowNetwork(nodeNum int) (*FlowNetwork, error) {
+	g, err := newFlowGraph(nodeNum)
+	if err != nil {
+		return nil, err
+	}
+	return &FlowNetwork{
+		flowGraph: g,
+		level:     make([]int, nodeNum),
+		iter:      make([]int, nodeNum),
+	}, nil
+}
+
+// AddEdge は、fromからtoへ容量capの辺を追加し、追加した辺の番号を返します.
+func (f *FlowNetwork) AddEdge(from, to, cap int) (int, error) {
+	return f.addEdge(from, to, cap, 0)
+}
+
+// Edge は、i番目に追加した辺の容量と現在の流量を返します.
+func (f *FlowNetwork) Edge(i int) FlowEdge {
+	return f.edge(i)
+}
+
+// Edges は、全ての辺の容量と現在の流量を追加した順に返します.
+func (f *FlowNetwork) Edges() []FlowEdge {
+	return f.edges()
+}
+
+// MaxFlow は、sからtへの最大流をDinic法で求めます.
+// 既にフローが流れている場合は、追加で流せた量を返します. 計算量はO(V^2E)です.
+func (f *FlowNetwork) MaxFlow(s, t int) (int, error) {
+	return f.MaxFlowWithLimit(s, t, flowInf)
+}
+
+// MaxFlowWithLimit は、sからtへ最大でlimitまでフローを流し、流せた量を返します.
+func (f *FlowNetwork) MaxFlowWithLimit(s, t, limit int) (int, error) {
+	if err := f.validateTerminals(s, t); err != nil {
+		return 0, err
+	}
+	flow := 0
+	for flow < limit {
+		f.bfs(s)
+		if f.level[t] < 0 {
+			break
+		}
+		for i := range f.iter {
+			f.iter[i] = 0
+		}
+		for flow < limit {
+			d := f.dfs(s, t, limit-flow)
+			if d == 0 {
+				break
+			}
+			flow += d
+		}
+	}
+	return flow, nil
+}
+
+func (f *FlowNetwork) bfs(s int) {
+	for i := range f.level {
+		f.level[i] = -1
+	}
+	f.level[s] = 0
+	queue := []int{s}
+	for head := 0; head < len(queue); head++ {
+		v := queue[head]
+		for _, e := range f.g[v] {
+			if e.cap > 0 && f.level[e.to] < 0 {
+				f.level[e.to] = f.level[v] + 1
+				queue = append(queue, e.to)
+			}
+		}
+	}
+}
+
+func (f *FlowNetwork) dfs(v, t, up int) int {
+	if v == t {
+		return up
+	}
+	for ; f.iter[v] < len(f.g[v]); f.iter[v]++ {
+		e := &f.g[v][f.iter[v]]
+		if e.cap <= 0 || f.level[v] >= f.level[e.to] {
+			continue
+		}
+		d := up
+		if e.cap < d {
+			d = e.cap
+		}
+		d = f.dfs(e.to, t, d)
+		if d > 0 {
+			e.cap -= d
+			f.g[e.to][e.rev].cap += d
+			return d
+		}
+	}
+	return 0
+}
+
+// MinCut は、残余グラフ上でsから到達可能な頂点をtrueとしたsliceを返します.
+// MaxFlowの後に呼び出すと、trueの頂点集合が最小カットのs側になります.
+func (f *FlowNetwork) MinCut(s int) []bool {
+	visited := make([]bool, f.n)
+	visited[s] = true
+	stack := []int{s}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range f.g[v] {
+			if e.cap > 0 && !visited[e.to] {
+				visited[e.to] = true
+				stack = append(stack, e.to)
+			}
+		}
+	}
+	return visited
+}
+
+// MinCostFlowNetwork は、最小費用流を求めるためのフローネットワークです.
+type MinCostFlowNetwork struct {
+	*flowGraph
+}
+
+// NewMinCostFlowNetwork は、nodeNum頂点からなる辺のないフローネットワークを返します.
+func NewMinCostFlowNetwork(nodeNum int) (*MinCostFlowNetwork, error) {
+	g, err := newFlowGraph(nodeNum)
+	if err != nil {
+		return nil, err
+	}
+	return &MinCostFlowNetwork{flowGraph: g}, nil
+}
+
+// AddEdge は、fromからtoへ容量cap、単位流量あたりのコストcostの辺を追加し、追加した辺の番号を返します.
+// costは0以上である必要があります.
+func (f *MinCostFlowNetwork) AddEdge(from, to, cap, cost int) (int, error) {
+	if cost < 0 {
+		return 0, fmt.Errorf("negative cost is given: %d", cost)
+	}
+	return f.addEdge(from, to, cap, cost)
+}
+
+// Edge は、i番目に追加した辺の容量、コストと現在の流量を返します.
+func (f *MinCostFlowNetwork) Edge(i int) FlowEdge {
+	return f.edge(i)
+}
+
+// Edges は、全ての辺の容量、コストと現在の流量を追加した順に返します.
+func (f *MinCostFlowNetwork) Edges() []FlowEdge {
+	return f.edges()
+}
+
+// Flow は、sからtへ最大でlimitまでフローを流し、流せた量とその最小コストを返します.
+func (f *MinCostFlowNetwork) Flow(s, t, limit int) (flow int, cost int, err error) {
+	slope, err := f.Slope(s, t, limit)
+	if err != nil {
+		return 0, 0, err
+	}
+	last := slope[len(slope)-1]
+	return last[0], last[1], nil
+}
+
+// Slope は、流量とその最小コストの関係を表す折れ線の頂点を(流量, コスト)の形で返します.
+// 最初の要素は(0, 0)、最後の要素はFlowの結果と一致し、傾きは単調増加です.
+// 主双対法(ポテンシャル付きDijkstra)を利用し、計算量はO(F(V+E)logV)です.
+func (f *MinCostFlowNetwork) Slope(s, t, limit int) ([][2]int, error) {
+	if err := f.validateTerminals(s, t); err != nil {
+		return nil, err
+	}
+	if limit < 0 {
+		return nil, fmt.Errorf("negative flow limit is given: %d", limit)
+	}
+
+	potential := make([]int, f.n)
+	dist := make([]int, f.n)
+	prevNode := make([]int, f.n)
+	prevEdge := make([]int, f.n)
+	flow, cost, prevCostPerFlow := 0, 0, -1
+	slope := [][2]int{{0, 0}}
+	for flow < limit {
+		if !f.dijkstra(s, t, potential, dist, prevNode, prevEdge) {
+			break
+		}
+		for v := 0; v < f.n; v++ {
+			if dist[v] != flowInf {
+				potential[v] += dist[v]
+			}
+		}
+		d := limit - flow
+		for v := t; v != s; v = prevNode[v] {
+			if c := f.g[prevNode[v]][prevEdge[v]].cap; c < d {
+				d = c
+			}
+		}
+		for v := t; v != s; v = prevNode[v] {
+			e := &f.g[prevNode[v]][prevEdge[v]]
+			e.cap -= d
+			f.g[v][e.rev].cap += d
+		}
+		costPerFlow := potential[t] - potential[s]
+		flow += d
+		cost += d * costPerFlow
+		if prevCostPerFlow == costPerFlow {
+			slope = slope[:len(slope)-1]
+		}
+		slope = append(slope, [2]int{flow, cost})
+		prevCostPerFlow = costPerFlow
+	}
+	return slope, nil
+}
+
+func (f *MinCostFlowNetwork) dijkstra(s, t int, potential, dist, prevNode, prevEdge []int) bool {
+	for i := range dist {
+		dist[i] = flowInf
+	}
+	visited := make([]bool, f.n)
+	dist[s] = 0
+	h := &flowDistHeap{{v: s, dist: 0}}
+	for h.Len() > 0 {
+		cur := heap.Pop(h).(flowDist)
+		v := cur.v
+		if visited[v] {
+			continue
+		}
+		visited[v] = true
+		for i, e := range f.g[v] {
+			if e.cap == 0 || visited[e.to] {
+				continue
+			}
+			nd := dist[v] + e.cost + potential[v] - potential[e.to]
+			if nd < dist[e.to] {
+				dist[e.to] = nd
+				prevNode[e.to] = v
+				prevEdge[e.to] = i
+				heap.Push(h, flowDist{v: e.to, dist: nd})
+			}
+		}
+	}
+	return visited[t]
+}
+
+type flowDist struct {
+	v, dist int
+}
+
+type flowDistHeap []flowDist
+
+func (h flowDistHeap) Len() int            { return len(h) }
+func (h flowDistHeap) Less(i, j int) bool  { return h[i].dist < h[j].dist }
+func (h flowDistHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *flowDistHeap) Push(x interface{}) { *h = append(*h, x.(flowDist)) }
+func (h *flowDistHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,485 @@
+package lib
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// bruteForceMinCut は、sを含みtを含まない全ての頂点集合を列挙してカットの容量の最小値を返します.
+func bruteForceMinCut(nodeNum int, edges [][]int, s, t int) int {
+	min := -1
+	for bits := 0; bits < 1<<nodeNum; bits++ {
+		if bits>>s&1 == 0 || bits>>t&1 == 1 {
+			continue
+		}
+		cut := 0
+		for _, e := range edges {
+			if bits>>e[0]&1 == 1 && bits>>e[1]&1 == 0 {
+				cut += e[2]
+			}
+		}
+		if min < 0 || cut < min {
+			min = cut
+		}
+	}
+	return min
+}
+
+// bruteForceMinCostFlow は、各辺の流量を全て列挙し、流量ごとの最小コストを返します. 流せない流量は-1になります.
+func bruteForceMinCostFlow(nodeNum int, edges [][]int, s, t int) []int {
+	maxFlow := 0
+	for _, e := range edges {
+		maxFlow += e[2]
+	}
+	costs := NewIntSliceWithInitialValue(maxFlow+1, -1)
+	flows := make([]int, len(edges))
+	var rec func(i int)
+	rec = func(i int) {
+		if i < len(edges) {
+			for f := 0; f <= edges[i][2]; f++ {
+				flows[i] = f
+				rec(i + 1)
+			}
+			return
+		}
+		balance := make([]int, nodeNum)
+		cost := 0
+		for j, e := range edges {
+			balance[e[0]] -= flows[j]
+			balance[e[1]] += flows[j]
+			cost += flows[j] * e[3]
+		}
+		for v := 0; v < nodeNum; v++ {
+			if v != s && v != t && balance[v] != 0 {
+				return
+			}
+		}
+		flow := balance[t]
+		if flow < 0 {
+			return
+		}
+		if costs[flow] < 0 || cost < costs[flow] {
+			costs[flow] = cost
+		}
+	}
+	rec(0)
+	return costs
+}
+
+func randomFlowEdges(r *rand.Rand, nodeNum, edgeNum, maxCap, maxCost int) (edges [][]int) {
+	for i := 0; i < edgeNum; i++ {
+		edges = append(edges, []int{r.Intn(nodeNum), r.Intn(nodeNum), r.Intn(maxCap + 1), r.Intn(maxCost + 1)})
+	}
+	return
+}
+
+func TestNewFlowNetwork(t *testing.T) {
+	tests := []struct {
+		name    string
+		nodeNum int
+		wantErr bool
+	}{
+		{name: "NewFlowNetwork", nodeNum: 1, wantErr: false},
+		{name: "NewFlowNetwork", nodeNum: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFlowNetwork(tt.nodeNum)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFlowNetwork() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlowNetwork_AddEdge(t *testing.T) {
+	type args struct {
+		from int
+		to   int
+		cap  int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    FlowEdge
+		wantErr bool
+	}{
+		{
+			name:    "FlowNetwork_AddEdge",
+			args:    args{from: 0, to: 1, cap: 3},
+			want:    FlowEdge{From: 0, To: 1, Cap: 3, Flow: 0},
+			wantErr: false,
+		},
+		{
+			name:    "FlowNetwork_AddEdge",
+			args:    args{from: 1, to: 1, cap: 3},
+			want:    FlowEdge{From: 1, To: 1, Cap: 3, Flow: 0},
+			wantErr: false,
+		},
+		{
+			name:    "FlowNetwork_AddEdge",
+			args:    args{from: 0, to: 2, cap: 3},
+			wantErr: true,
+		},
+		{
+			name:    "FlowNetwork_AddEdge",
+			args:    args{from: 0, to: 1, cap: -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := MustNewFlowNetwork(2)
+			i, err := f.AddEdge(tt.args.from, tt.args.to, tt.args.cap)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FlowNetwork.AddEdge() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := f.Edge(i); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlowNetwork.Edge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlowNetwork_MaxFlow(t *testing.T) {
+	type args struct {
+		nodeNum int
+		edges   [][]int
+		s       int
+		t       int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantCut []bool
+		wantErr bool
+	}{
+		{
+			name: "FlowNetwork_MaxFlow",
+			args: args{
+				// 0 -> 1 -> 3, 0 -> 2 -> 3, 1 -> 2
+				nodeNum: 4,
+				edges:   [][]int{{0, 1, 2}, {0, 2, 1}, {1, 2, 1}, {1, 3, 1}, {2, 3, 2}},
+				s:       0,
+				t:       3,
+			},
+			want:    3,
+			wantCut: []bool{true, false, false, false},
+		},
+		{
+			name: "FlowNetwork_MaxFlow",
+			args: args{
+				nodeNum: 3,
+				edges:   [][]int{{0, 1, 5}, {1, 2, 2}},
+				s:       0,
+				t:       2,
+			},
+			want:    2,
+			wantCut: []bool{true, true, false},
+		},
+		{
+			name: "FlowNetwork_MaxFlow",
+			args: args{
+				nodeNum: 3,
+				edges:   [][]int{{0, 1, 5}, {2, 1, 2}},
+				s:       0,
+				t:       2,
+			},
+			want:    0,
+			wantCut: []bool{true, true, false},
+		},
+		{
+			name: "FlowNetwork_MaxFlow",
+			args: args{
+				nodeNum: 2,
+				edges:   [][]int{{0, 1, 5}},
+				s:       1,
+				t:       1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := MustNewFlowNetwork(tt.args.nodeNum)
+			for _, e := range tt.args.edges {
+				f.MustAddEdge(e[0], e[1], e[2])
+			}
+			got, err := f.MaxFlow(tt.args.s, tt.args.t)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FlowNetwork.MaxFlow() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FlowNetwork.MaxFlow() = %v, want %v", got, tt.want)
+			}
+			if gotCut := f.MinCut(tt.args.s); !reflect.DeepEqual(gotCut, tt.wantCut) {
+				t.Errorf("FlowNetwork.MinCut() = %v, want %v", gotCut, tt.wantCut)
+			}
+		})
+	}
+}
+
+func TestFlowNetwork_MaxFlowWithLimit(t *testing.T) {
+	f := MustNewFlowNetwork(4)
+	for _, e := range [][]int{{0, 1, 2}, {0, 2, 1}, {1, 2, 1}, {1, 3, 1}, {2, 3, 2}} {
+		f.MustAddEdge(e[0], e[1], e[2])
+	}
+	if got := f.MustMaxFlowWithLimit(0, 3, 2); got != 2 {
+		t.Errorf("FlowNetwork.MaxFlowWithLimit() = %v, want %v", got, 2)
+	}
+	// 既に流れているフローに加えて、残りの1だけ流せる
+	if got := f.MustMaxFlow(0, 3); got != 1 {
+		t.Errorf("FlowNetwork.MaxFlow() after MaxFlowWithLimit = %v, want %v", got, 1)
+	}
+}
+
+func TestFlowNetwork_MaxFlow_BruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		nodeNum := 2 + r.Intn(5)
+		edges := randomFlowEdges(r, nodeNum, r.Intn(10), 5, 0)
+		f := MustNewFlowNetwork(nodeNum)
+		for _, e := range edges {
+			f.MustAddEdge(e[0], e[1], e[2])
+		}
+
+		want := bruteForceMinCut(nodeNum, edges, 0, nodeNum-1)
+		got := f.MustMaxFlow(0, nodeNum-1)
+		if got != want {
+			t.Fatalf("FlowNetwork.MaxFlow() = %v, want %v, edges: %v", got, want, edges)
+		}
+
+		cut := f.MinCut(0)
+		if !cut[0] || cut[nodeNum-1] {
+			t.Fatalf("FlowNetwork.MinCut() = %v is not s-t cut, edges: %v", cut, edges)
+		}
+		cutCap := 0
+		for _, e := range f.Edges() {
+			if cut[e.From] && !cut[e.To] {
+				cutCap += e.Cap
+			}
+		}
+		if cutCap != want {
+			t.Fatalf("capacity of FlowNetwork.MinCut() = %v, want %v, edges: %v", cutCap, want, edges)
+		}
+	}
+}
+
+func TestMinCostFlowNetwork_Slope(t *testing.T) {
+	type args struct {
+		nodeNum int
+		edges   [][]int
+		s       int
+		t       int
+		limit   int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    [][2]int
+		wantErr bool
+	}{
+		{
+			name: "MinCostFlowNetwork_Slope",
+			args: args{
+				// 0 -> 1 -> 3 (cost 1+1), 0 -> 2 -> 3 (cost 2+3), 1 -> 2 (cost 1)
+				nodeNum: 4,
+				edges:   [][]int{{0, 1, 2, 1}, {0, 2, 1, 2}, {1, 2, 1, 1}, {1, 3, 1, 1}, {2, 3, 2, 3}},
+				s:       0,
+				t:       3,
+				limit:   10,
+			},
+			want: [][2]int{{0, 0}, {1, 2}, {3, 12}},
+		},
+		{
+			name: "MinCostFlowNetwork_Slope",
+			args: args{
+				nodeNum: 4,
+				edges:   [][]int{{0, 1, 2, 1}, {0, 2, 1, 2}, {1, 2, 1, 1}, {1, 3, 1, 1}, {2, 3, 2, 3}},
+				s:       0,
+				t:       3,
+				limit:   2,
+			},
+			want: [][2]int{{0, 0}, {1, 2}, {2, 7}},
+		},
+		{
+			name: "MinCostFlowNetwork_Slope",
+			args: args{
+				nodeNum: 2,
+				edges:   [][]int{{0, 1, 2, 1}},
+				s:       0,
+				t:       1,
+				limit:   -1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := MustNewMinCostFlowNetwork(tt.args.nodeNum)
+			for _, e := range tt.args.edges {
+				f.MustAddEdge(e[0], e[1], e[2], e[3])
+			}
+			got, err := f.Slope(tt.args.s, tt.args.t, tt.args.limit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MinCostFlowNetwork.Slope() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MinCostFlowNetwork.Slope() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinCostFlowNetwork_Flow(t *testing.T) {
+	type args struct {
+		nodeNum int
+		edges   [][]int
+		s       int
+		t       int
+		limit   int
+	}
+	// 0 -> 1 -> 3 (cost 1+1), 0 -> 2 -> 3 (cost 2+3), 1 -> 2 (cost 1)
+	diamond := [][]int{{0, 1, 2, 1}, {0, 2, 1, 2}, {1, 2, 1, 1}, {1, 3, 1, 1}, {2, 3, 2, 3}}
+	tests := []struct {
+		name     string
+		args     args
+		wantFlow int
+		wantCost int
+	}{
+		{
+			name:     "流量の上限で止まる",
+			args:     args{nodeNum: 4, edges: diamond, s: 0, t: 3, limit: 2},
+			wantFlow: 2,
+			wantCost: 7,
+		},
+		{
+			name:     "最大流まで流す",
+			args:     args{nodeNum: 4, edges: diamond, s: 0, t: 3, limit: 10},
+			wantFlow: 3,
+			wantCost: 12,
+		},
+		{
+			name:     "流量の上限が0",
+			args:     args{nodeNum: 4, edges: diamond, s: 0, t: 3, limit: 0},
+			wantFlow: 0,
+			wantCost: 0,
+		},
+		{
+			// 1本目のフロー0 -> 1 -> 2 -> 3を、2本目で1 -> 2の逆辺を使って打ち消す
+			name: "逆辺で打ち消す",
+			args: args{
+				nodeNum: 4,
+				edges:   [][]int{{0, 1, 1, 1}, {1, 2, 1, 1}, {2, 3, 1, 1}, {0, 2, 1, 5}, {1, 3, 1, 5}},
+				s:       0,
+				t:       3,
+				limit:   2,
+			},
+			wantFlow: 2,
+			wantCost: 12,
+		},
+		{
+			name: "コストの異なる多重辺",
+			args: args{
+				nodeNum: 2,
+				edges:   [][]int{{0, 1, 1, 5}, {0, 1, 2, 1}},
+				s:       0,
+				t:       1,
+				limit:   3,
+			},
+			wantFlow: 3,
+			wantCost: 7,
+		},
+		{
+			name: "自己ループは使わない",
+			args: args{
+				nodeNum: 2,
+				edges:   [][]int{{0, 0, 3, 0}, {0, 1, 1, 4}},
+				s:       0,
+				t:       1,
+				limit:   5,
+			},
+			wantFlow: 1,
+			wantCost: 4,
+		},
+		{
+			name: "tに到達できない",
+			args: args{
+				nodeNum: 3,
+				edges:   [][]int{{0, 1, 2, 1}, {2, 1, 2, 1}},
+				s:       0,
+				t:       2,
+				limit:   5,
+			},
+			wantFlow: 0,
+			wantCost: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := MustNewMinCostFlowNetwork(tt.args.nodeNum)
+			for _, e := range tt.args.edges {
+				f.MustAddEdge(e[0], e[1], e[2], e[3])
+			}
+			flow, cost := f.MustFlow(tt.args.s, tt.args.t, tt.args.limit)
+			if flow != tt.wantFlow || cost != tt.wantCost {
+				t.Errorf("MinCostFlowNetwork.Flow() = (%v, %v), want (%v, %v)", flow, cost, tt.wantFlow, tt.wantCost)
+			}
+
+			// 各辺の流量の合計が、Flowの返した流量とコストに一致する
+			flowSum, costSum := make([]int, tt.args.nodeNum), 0
+			for i, e := range f.Edges() {
+				flowSum[e.From] -= e.Flow
+				flowSum[e.To] += e.Flow
+				costSum += e.Flow * tt.args.edges[i][3]
+			}
+			if flowSum[tt.args.t] != flow || costSum != cost {
+				t.Errorf("sum of MinCostFlowNetwork.Edges() = (%v, %v), want (%v, %v)", flowSum[tt.args.t], costSum, flow, cost)
+			}
+		})
+	}
+}
+
+func TestMinCostFlowNetwork_Flow_BruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 300; i++ {
+		nodeNum := 2 + r.Intn(3)
+		edges := randomFlowEdges(r, nodeNum, r.Intn(6), 2, 5)
+		want := bruteForceMinCostFlow(nodeNum, edges, 0, nodeNum-1)
+		for limit := 0; limit < len(want); limit++ {
+			f := MustNewMinCostFlowNetwork(nodeNum)
+			for _, e := range edges {
+				f.MustAddEdge(e[0], e[1], e[2], e[3])
+			}
+			flow, cost := f.MustFlow(0, nodeNum-1, limit)
+			wantFlow := limit
+			for want[wantFlow] < 0 {
+				wantFlow--
+			}
+			if flow != wantFlow || cost != want[wantFlow] {
+				t.Fatalf("MinCostFlowNetwork.Flow(limit=%d) = (%v, %v), want (%v, %v), edges: %v",
+					limit, flow, cost, wantFlow, want[wantFlow], edges)
+			}
+
+			flowSum := make([]int, nodeNum)
+			for _, e := range f.Edges() {
+				flowSum[e.From] -= e.Flow
+				flowSum[e.To] += e.Flow
+			}
+			if flowSum[nodeNum-1] != flow {
+				t.Fatalf("sum of MinCostFlowNetwork.Edges() flow = %v, want %v", flowSum[nodeNum-1], flow)
+			}
+		}
+	}
+}
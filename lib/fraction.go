@@ -0,0 +1,137 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Fraction は、既約分数Num/Denを表します. Denは常に正で、0は0/1で表します.
+// 既約であるため、==で値が等しいかを比較できます.
+type Fraction struct {
+	Num, Den int
+}
+
+// NewFraction は、num/denを約分したFractionを返します. denが0の場合や、符号の反転がオーバーフローする場合はエラーを返します.
+func NewFraction(num, den int) (Fraction, error) {
+	if den == 0 {
+		return Fraction{}, errors.New("denominator is 0")
+	}
+	if den < 0 {
+		if -num == num && num != 0 || -den == den {
+			return Fraction{}, fmt.Errorf("negating %d/%d overflows", num, den)
+		}
+		num, den = -num, -den
+	}
+	g := gcd(num, den)
+	if g < 0 {
+		g = -g
+	}
+	return Fraction{Num: num / g, Den: den / g}, nil
+}
+
+// Add は、f+gを返します. 計算途中でオーバーフローする場合はエラーを返すため、その場合はRatを利用してください.
+func (f Fraction) Add(g Fraction) (Fraction, error) {
+	d := gcd(f.Den, g.Den)
+	a, err := CheckedMulInt(f.Num, g.Den/d)
+	if err != nil {
+		return Fraction{}, err
+	}
+	b, err := CheckedMulInt(g.Num, f.Den/d)
+	if err != nil {
+		return Fraction{}, err
+	}
+	num, err := CheckedAddInt(a, b)
+	if err != nil {
+		return Fraction{}, err
+	}
+	den, err := CheckedMulInt(f.Den/d, g.Den)
+	if err != nil {
+		return Fraction{}, err
+	}
+	return NewFraction(num, den)
+}
+
+// Neg は、-fを返します. f.Numが最小値の場合はエラーを返します.
+func (f Fraction) Neg() (Fraction, error) {
+	return NewFraction(f.Num, -f.Den)
+}
+
+// Sub は、f-gを返します. 計算途中でオーバーフローする場合はエラーを返します.
+func (f Fraction) Sub(g Fraction) (Fraction, error) {
+	ng, err := g.Neg()
+	if err != nil {
+		return Fraction{}, err
+	}
+	return f.Add(ng)
+}
+
+// Mul は、f*gを返します. 先に約分してからかけるため、結果が収まる場合はオーバーフローしにくくなっています.
+func (f Fraction) Mul(g Fraction) (Fraction, error) {
+	g1, g2 := gcd(f.Num, g.Den), gcd(g.Num, f.Den)
+	if g1 < 0 {
+		g1 = -g1
+	}
+	if g2 < 0 {
+		g2 = -g2
+	}
+	num, err := CheckedMulInt(f.Num/g1, g.Num/g2)
+	if err != nil {
+		return Fraction{}, err
+	}
+	den, err := CheckedMulInt(f.Den/g2, g.Den/g1)
+	if err != nil {
+		return Fraction{}, err
+	}
+	return NewFraction(num, den)
+}
+
+// Inv は、1/fを返します. fが0の場合はエラーを返します.
+func (f Fraction) Inv() (Fraction, error) {
+	return NewFraction(f.Den, f.Num)
+}
+
+// Div は、f/gを返します. gが0の場合や、計算途中でオーバーフローする場合はエラーを返します.
+func (f Fraction) Div(g Fraction) (Fraction, error) {
+	ig, err := g.Inv()
+	if err != nil {
+		return Fraction{}, err
+	}
+	return f.Mul(ig)
+}
+
+// Cmp は、f < gなら-1、f == gなら0、f > gなら1を返します. オーバーフローする場合はbig.Ratで比較するため、常に正しい結果を返します.
+func (f Fraction) Cmp(g Fraction) int {
+	a, errA := CheckedMulInt(f.Num, g.Den)
+	b, errB := CheckedMulInt(g.Num, f.Den)
+	if errA != nil || errB != nil {
+		return f.Rat().Cmp(g.Rat())
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Less は、f < gであるかを返します. sort.Sliceなどで利用できます.
+func (f Fraction) Less(g Fraction) bool {
+	return f.Cmp(g) < 0
+}
+
+// Float64 は、fをfloat64に変換した値を返します.
+func (f Fraction) Float64() float64 {
+	return float64(f.Num) / float64(f.Den)
+}
+
+// Rat は、fをbig.Ratに変換した値を返します. オーバーフローする計算はbig.Ratで行ってください.
+func (f Fraction) Rat() *big.Rat {
+	return big.NewRat(int64(f.Num), int64(f.Den))
+}
+
+// String は、fを"Num/Den"の形式で返します.
+func (f Fraction) String() string {
+	return fmt.Sprintf("%d/%d", f.Num, f.Den)
+}
@@ -0,0 +1,149 @@
+package lib
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestNewFraction(t *testing.T) {
+	type args struct {
+		num int
+		den int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Fraction
+		wantErr bool
+	}{
+		{
+			name: "NewFraction",
+			args: args{num: 6, den: 4},
+			want: Fraction{Num: 3, Den: 2},
+		},
+		{
+			name: "NewFraction",
+			args: args{num: 6, den: -4},
+			want: Fraction{Num: -3, Den: 2},
+		},
+		{
+			name: "NewFraction",
+			args: args{num: 0, den: -5},
+			want: Fraction{Num: 0, Den: 1},
+		},
+		{
+			name: "NewFraction",
+			args: args{num: math.MinInt64, den: 2},
+			want: Fraction{Num: math.MinInt64 / 2, Den: 1},
+		},
+		{
+			name:    "NewFraction",
+			args:    args{num: 1, den: 0},
+			wantErr: true,
+		},
+		{
+			name:    "NewFraction",
+			args:    args{num: math.MinInt64, den: -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFraction(tt.args.num, tt.args.den)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFraction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewFraction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFraction_Arithmetic(t *testing.T) {
+	// 小さな分数の全ての組み合わせについて、big.Ratで計算した結果と比較する
+	var fs []Fraction
+	for num := -6; num <= 6; num++ {
+		for den := 1; den <= 6; den++ {
+			fs = append(fs, MustNewFraction(num, den))
+		}
+	}
+	for _, f := range fs {
+		for _, g := range fs {
+			ops := []struct {
+				name string
+				f    func(Fraction) (Fraction, error)
+				rat  func(x, y *big.Rat) *big.Rat
+			}{
+				{name: "Add", f: f.Add, rat: new(big.Rat).Add},
+				{name: "Sub", f: f.Sub, rat: new(big.Rat).Sub},
+				{name: "Mul", f: f.Mul, rat: new(big.Rat).Mul},
+			}
+			if g.Num != 0 {
+				ops = append(ops, struct {
+					name string
+					f    func(Fraction) (Fraction, error)
+					rat  func(x, y *big.Rat) *big.Rat
+				}{name: "Div", f: f.Div, rat: new(big.Rat).Quo})
+			}
+			for _, op := range ops {
+				got, err := op.f(g)
+				if err != nil {
+					t.Fatalf("Fraction.%s(%v, %v) error = %v", op.name, f, g, err)
+				}
+				if want := op.rat(f.Rat(), g.Rat()); got.Rat().Cmp(want) != 0 {
+					t.Fatalf("Fraction.%s(%v, %v) = %v, want %v", op.name, f, g, got, want)
+				}
+			}
+			if got, want := f.Cmp(g), f.Rat().Cmp(g.Rat()); got != want {
+				t.Fatalf("Fraction.Cmp(%v, %v) = %d, want %d", f, g, got, want)
+			}
+		}
+	}
+
+	if _, err := MustNewFraction(1, 2).Div(Fraction{Num: 0, Den: 1}); err == nil {
+		t.Errorf("Fraction.Div() by 0 error = nil, want error")
+	}
+	if _, err := MustNewFraction(1, math.MaxInt64).Add(MustNewFraction(1, math.MaxInt64-1)); err == nil {
+		t.Errorf("Fraction.Add() error = nil, want overflow error")
+	}
+}
+
+func TestFraction_Cmp(t *testing.T) {
+	// 交差積がオーバーフローする場合もbig.Ratで正しく比較できる
+	a := MustNewFraction(math.MaxInt64-1, math.MaxInt64)
+	b := MustNewFraction(math.MaxInt64-2, math.MaxInt64-1)
+	if got := a.Cmp(b); got != 1 {
+		t.Errorf("Fraction.Cmp() = %v, want %v", got, 1)
+	}
+
+	fs := []Fraction{MustNewFraction(1, 2), MustNewFraction(-1, 3), MustNewFraction(2, 3), MustNewFraction(0, 1)}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].Less(fs[j]) })
+	want := []Fraction{{Num: -1, Den: 3}, {Num: 0, Den: 1}, {Num: 1, Den: 2}, {Num: 2, Den: 3}}
+	if !reflect.DeepEqual(fs, want) {
+		t.Errorf("sorted fractions = %v, want %v", fs, want)
+	}
+	if got := MustNewFraction(3, 4).String(); got != "3/4" {
+		t.Errorf("Fraction.String() = %v, want %v", got, "3/4")
+	}
+}
+
+func TestBigPow(t *testing.T) {
+	if _, err := CheckedPowInt(10, 19); err == nil {
+		t.Fatalf("CheckedPowInt(10, 19) error = nil, want error")
+	}
+	got := MustBigPow(10, 19)
+	if want, _ := new(big.Int).SetString("10000000000000000000", 10); got.Cmp(want) != 0 {
+		t.Errorf("BigPow() = %v, want %v", got, want)
+	}
+	if _, err := BigToInt(got); err == nil {
+		t.Errorf("BigToInt() error = nil, want error")
+	}
+	if v := MustBigToInt(new(big.Int).Sub(BigMul(math.MaxInt64, 2), BigAdd(math.MaxInt64, 0))); v != math.MaxInt64 {
+		t.Errorf("BigToInt() = %v, want %v", v, math.MaxInt64)
+	}
+}
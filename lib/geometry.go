@@ -0,0 +1,327 @@
+package lib
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// Point は、整数座標の点またはベクトルです. 座標の積がintに収まる範囲であれば、以下の計算は全て誤差なく行えます.
+type Point struct {
+	X, Y int
+}
+
+// Add は、p+qを返します.
+func (p Point) Add(q Point) Point {
+	return Point{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+// Sub は、p-qを返します.
+func (p Point) Sub(q Point) Point {
+	return Point{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+// Dot は、pとqの内積を返します.
+func (p Point) Dot(q Point) int {
+	return p.X*q.X + p.Y*q.Y
+}
+
+// Cross は、pとqの外積(p.X*q.Y - p.Y*q.X)を返します. qがpから見て反時計回り側にある場合に正になります.
+func (p Point) Cross(q Point) int {
+	return p.X*q.Y - p.Y*q.X
+}
+
+// Norm2 は、pの長さの2乗を返します.
+func (p Point) Norm2() int {
+	return p.Dot(p)
+}
+
+// pointLess は、pがqよりx座標、y座標の順で辞書順に小さいかを返します.
+func pointLess(p, q Point) bool {
+	if p.X != q.X {
+		return p.X < q.X
+	}
+	return p.Y < q.Y
+}
+
+// Orientation は、a->b->cが反時計回りなら1、時計回りなら-1、一直線上なら0を返します.
+func Orientation(a, b, c Point) int {
+	cr := b.Sub(a).Cross(c.Sub(a))
+	switch {
+	case cr > 0:
+		return 1
+	case cr < 0:
+		return -1
+	}
+	return 0
+}
+
+// OnSegment は、点pが線分ab上(端点を含む)にあるかを返します.
+func OnSegment(p, a, b Point) bool {
+	return Orientation(a, b, p) == 0 && a.Sub(p).Dot(b.Sub(p)) <= 0
+}
+
+// SegmentsIntersect は、線分abと線分cdが共有点を持つか(端点での接触や重なりを含む)を返します.
+func SegmentsIntersect(a, b, c, d Point) bool {
+	o1, o2 := Orientation(a, b, c), Orientation(a, b, d)
+	o3, o4 := Orientation(c, d, a), Orientation(c, d, b)
+	if o1*o2 < 0 && o3*o4 < 0 {
+		return true
+	}
+	return OnSegment(c, a, b) || OnSegment(d, a, b) || OnSegment(a, c, d) || OnSegment(b, c, d)
+}
+
+// PolygonArea2 は、多角形polyの符号付き面積の2倍を返します. 頂点が反時計回りに並んでいる場合に正になります.
+// 2倍の値を返すことで、整数座標の多角形の面積を誤差なく扱えます.
+func PolygonArea2(poly []Point) int {
+	area := 0
+	for i, p := range poly {
+		area += p.Cross(poly[(i+1)%len(poly)])
+	}
+	return area
+}
+
+// PointInPolygonの結果です.
+const (
+	PolygonOutside = iota
+	PolygonOnBoundary
+	PolygonInside
+)
+
+// PointInPolygon は、点pが多角形polyの外部ならPolygonOutside、辺上ならPolygonOnBoundary、内部ならPolygonInsideを返します.
+// polyは自己交差しない多角形であれば凸である必要はなく、頂点の向きも問いません. 計算量はO(N)です.
+func PointInPolygon(p Point, poly []Point) int {
+	in := false
+	for i := range poly {
+		a, b := poly[i].Sub(p), poly[(i+1)%len(poly)].Sub(p)
+		if a.Cross(b) == 0 && a.Dot(b) <= 0 {
+			return PolygonOnBoundary
+		}
+		if a.Y > b.Y {
+			a, b = b, a
+		}
+		// pから右向きに伸ばした半直線と辺が交差する回数を数える
+		if a.Y <= 0 && 0 < b.Y && a.Cross(b) > 0 {
+			in = !in
+		}
+	}
+	if in {
+		return PolygonInside
+	}
+	return PolygonOutside
+}
+
+// convexHullIndices は、ConvexHullと同じ凸包を、pointsのindexとして返します.
+func convexHullIndices(points []Point) []int {
+	idx := make([]int, len(points))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return pointLess(points[idx[i]], points[idx[j]]) })
+	uniq := idx[:0]
+	for _, i := range idx {
+		if len(uniq) == 0 || points[uniq[len(uniq)-1]] != points[i] {
+			uniq = append(uniq, i)
+		}
+	}
+	if len(uniq) <= 2 {
+		return uniq
+	}
+
+	hull := make([]int, 0, 2*len(uniq))
+	// 下側の凸包を左から、上側の凸包を右から構築する
+	for _, order := range [][]int{uniq, ReverseInt(uniq)} {
+		start := len(hull)
+		for _, i := range order {
+			for len(hull) >= start+2 && Orientation(points[hull[len(hull)-2]], points[hull[len(hull)-1]], points[i]) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, i)
+		}
+		hull = hull[:len(hull)-1]
+	}
+	return hull
+}
+
+// ConvexHull は、pointsの凸包の頂点を、x座標が最小の点(複数あればy座標が最小の点)から反時計回りに返します.
+// 辺上の点は含みません. 全ての点が一直線上にある場合は両端の2点を返します. Andrewのmonotone chainにより、計算量はO(N log N)です.
+func ConvexHull(points []Point) []Point {
+	var hull []Point
+	for _, i := range convexHullIndices(points) {
+		hull = append(hull, points[i])
+	}
+	return hull
+}
+
+// ClosestPair は、pointsの中で最も近い2点のindexを返します. 点が2つ未満の場合はエラーを返します.
+// 分割統治法により、計算量はO(N log N)です.
+func ClosestPair(points []Point) (i, j int, err error) {
+	if len(points) < 2 {
+		return 0, 0, errors.New("at least 2 points are required")
+	}
+	idx := make([]int, len(points))
+	for k := range idx {
+		idx[k] = k
+	}
+	sort.Slice(idx, func(a, b int) bool { return pointLess(points[idx[a]], points[idx[b]]) })
+
+	best := math.MaxInt64
+	buf := make([]int, len(points))
+	// solve は、x座標順に並んだidxの最近点対を更新しながら、idxをy座標順に並べ替える
+	var solve func(idx []int)
+	solve = func(idx []int) {
+		if len(idx) <= 1 {
+			return
+		}
+		mid := len(idx) / 2
+		midX := points[idx[mid]].X
+		solve(idx[:mid])
+		solve(idx[mid:])
+
+		// y座標順にマージする
+		l, r, merged := 0, mid, buf[:0]
+		for l < mid || r < len(idx) {
+			if r == len(idx) || (l < mid && points[idx[l]].Y <= points[idx[r]].Y) {
+				merged = append(merged, idx[l])
+				l++
+			} else {
+				merged = append(merged, idx[r])
+				r++
+			}
+		}
+		copy(idx, merged)
+
+		// 境界からの距離がbest未満の点について、y座標の差がbest未満の点とだけ比較する
+		var near []int
+		for _, k := range idx {
+			dx := points[k].X - midX
+			if dx*dx >= best {
+				continue
+			}
+			for m := len(near) - 1; m >= 0; m-- {
+				dy := points[k].Y - points[near[m]].Y
+				if dy*dy >= best {
+					break
+				}
+				if d := points[k].Sub(points[near[m]]).Norm2(); d < best {
+					best, i, j = d, near[m], k
+				}
+			}
+			near = append(near, k)
+		}
+	}
+	solve(idx)
+	if i > j {
+		i, j = j, i
+	}
+	return i, j, nil
+}
+
+// FarthestPair は、pointsの中で最も遠い2点(直径)のindexを返します. 点が1つもない場合はエラーを返します.
+// 凸包に対してキャリパー法(rotating calipers)を行うため、計算量はO(N log N)です.
+func FarthestPair(points []Point) (i, j int, err error) {
+	if len(points) == 0 {
+		return 0, 0, errors.New("empty points are given")
+	}
+	hull := convexHullIndices(points)
+	n := len(hull)
+	if n == 1 {
+		return hull[0], hull[0], nil
+	}
+
+	best := -1
+	update := func(a, b int) {
+		if d := points[a].Sub(points[b]).Norm2(); d > best {
+			best, i, j = d, a, b
+		}
+	}
+	for s, t := 0, 1; s < n; s++ {
+		edge := points[hull[(s+1)%n]].Sub(points[hull[s]])
+		// 辺sから最も遠い頂点tまで進める
+		for edge.Cross(points[hull[(t+1)%n]].Sub(points[hull[t]])) > 0 {
+			t = (t + 1) % n
+		}
+		update(hull[s], hull[t])
+		update(hull[(s+1)%n], hull[t])
+	}
+	if i > j {
+		i, j = j, i
+	}
+	return i, j, nil
+}
+
+// PointF は、実数座標の点またはベクトルです.
+type PointF struct {
+	X, Y float64
+}
+
+// Add は、p+qを返します.
+func (p PointF) Add(q PointF) PointF {
+	return PointF{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+// Sub は、p-qを返します.
+func (p PointF) Sub(q PointF) PointF {
+	return PointF{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+// Mul は、pをk倍したベクトルを返します.
+func (p PointF) Mul(k float64) PointF {
+	return PointF{X: p.X * k, Y: p.Y * k}
+}
+
+// Dot は、pとqの内積を返します.
+func (p PointF) Dot(q PointF) float64 {
+	return p.X*q.X + p.Y*q.Y
+}
+
+// Cross は、pとqの外積を返します.
+func (p PointF) Cross(q PointF) float64 {
+	return p.X*q.Y - p.Y*q.X
+}
+
+// Abs は、pの長さを返します.
+func (p PointF) Abs() float64 {
+	return math.Hypot(p.X, p.Y)
+}
+
+// OrientationF は、a->b->cが反時計回りなら1、時計回りなら-1を返します. 外積の絶対値がeps以下の場合は一直線上とみなして0を返します.
+func OrientationF(a, b, c PointF, eps float64) int {
+	cr := b.Sub(a).Cross(c.Sub(a))
+	switch {
+	case cr > eps:
+		return 1
+	case cr < -eps:
+		return -1
+	}
+	return 0
+}
+
+// Circle は、中心C、半径Rの円です.
+type Circle struct {
+	C PointF
+	R float64
+}
+
+// CircleIntersection は、円c1と円c2の交点を返します. 交点がない場合は空のslice、接する場合は1点を返します.
+// 距離の比較は誤差epsを許容して行います. 2つの円が一致し交点が無数にある場合はエラーを返します.
+func CircleIntersection(c1, c2 Circle, eps float64) ([]PointF, error) {
+	v := c2.C.Sub(c1.C)
+	d := v.Abs()
+	if d <= eps && math.Abs(c1.R-c2.R) <= eps {
+		return nil, errors.New("circles are identical")
+	}
+	if d > c1.R+c2.R+eps || d < math.Abs(c1.R-c2.R)-eps || d <= eps {
+		return []PointF{}, nil
+	}
+
+	// c1の中心から、2つの交点を結ぶ線分の中点までの距離aと、中点から交点までの距離hを求める
+	a := (d*d + c1.R*c1.R - c2.R*c2.R) / (2 * d)
+	mid := c1.C.Add(v.Mul(a / d))
+	if math.Abs(d-(c1.R+c2.R)) <= eps || math.Abs(d-math.Abs(c1.R-c2.R)) <= eps {
+		return []PointF{mid}, nil
+	}
+	h := math.Sqrt(math.Max(0, c1.R*c1.R-a*a))
+	n := PointF{X: -v.Y, Y: v.X}.Mul(h / d)
+	return []PointF{mid.Add(n), mid.Sub(n)}, nil
+}
@@ -0,0 +1,37 @@
+package lib
+
+import "fmt"
+
+func ExampleConvexHull() {
+	points := []Point{{0, 0}, {4, 0}, {2, 1}, {4, 4}, {0, 4}, {2, 4}}
+	hull := ConvexHull(points)
+	fmt.Println(hull)
+	// 面積は2倍の値で誤差なく得られる
+	fmt.Println(PolygonArea2(hull))
+
+	// Output:
+	// [{0 0} {4 0} {4 4} {0 4}]
+	// 32
+}
+
+func ExampleFarthestPair() {
+	points := []Point{{0, 0}, {1, 3}, {-2, 1}, {3, -1}}
+	i, j := MustFarthestPair(points)
+	fmt.Println(points[i], points[j], points[i].Sub(points[j]).Norm2())
+
+	// Output:
+	// {-2 1} {3 -1} 29
+}
+
+func ExampleCircleIntersection() {
+	c1 := Circle{C: PointF{X: 0, Y: 0}, R: 5}
+	c2 := Circle{C: PointF{X: 8, Y: 0}, R: 5}
+	points, _ := CircleIntersection(c1, c2, 1e-9)
+	for _, p := range points {
+		fmt.Printf("%.3f %.3f\n", p.X, p.Y)
+	}
+
+	// Output:
+	// 4.000 3.000
+	// 4.000 -3.000
+}
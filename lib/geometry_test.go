@@ -0,0 +1,293 @@
+package lib
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSegmentsIntersect(t *testing.T) {
+	tests := []struct {
+		name       string
+		a, b, c, d Point
+		want       bool
+	}{
+		{
+			name: "SegmentsIntersect",
+			a:    Point{0, 0}, b: Point{2, 2}, c: Point{0, 2}, d: Point{2, 0},
+			want: true,
+		},
+		{
+			// 端点で接する
+			name: "SegmentsIntersect",
+			a:    Point{0, 0}, b: Point{2, 2}, c: Point{2, 2}, d: Point{3, 0},
+			want: true,
+		},
+		{
+			// 一直線上で重なる
+			name: "SegmentsIntersect",
+			a:    Point{0, 0}, b: Point{2, 0}, c: Point{1, 0}, d: Point{3, 0},
+			want: true,
+		},
+		{
+			// 一直線上で離れている
+			name: "SegmentsIntersect",
+			a:    Point{0, 0}, b: Point{1, 0}, c: Point{2, 0}, d: Point{3, 0},
+			want: false,
+		},
+		{
+			name: "SegmentsIntersect",
+			a:    Point{0, 0}, b: Point{1, 1}, c: Point{1, 0}, d: Point{2, -1},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SegmentsIntersect(tt.a, tt.b, tt.c, tt.d); got != tt.want {
+				t.Errorf("SegmentsIntersect() = %v, want %v", got, tt.want)
+			}
+			if got := SegmentsIntersect(tt.d, tt.c, tt.b, tt.a); got != tt.want {
+				t.Errorf("SegmentsIntersect() with reversed segments = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointInPolygon(t *testing.T) {
+	// L字型の凹多角形
+	poly := []Point{{0, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 4}, {0, 4}}
+	tests := []struct {
+		name string
+		p    Point
+		want int
+	}{
+		{name: "PointInPolygon", p: Point{1, 1}, want: PolygonInside},
+		{name: "PointInPolygon", p: Point{1, 3}, want: PolygonInside},
+		{name: "PointInPolygon", p: Point{3, 3}, want: PolygonOutside},
+		{name: "PointInPolygon", p: Point{2, 3}, want: PolygonOnBoundary},
+		{name: "PointInPolygon", p: Point{4, 0}, want: PolygonOnBoundary},
+		{name: "PointInPolygon", p: Point{5, 2}, want: PolygonOutside},
+		{name: "PointInPolygon", p: Point{-1, 2}, want: PolygonOutside},
+		{name: "PointInPolygon", p: Point{3, 2}, want: PolygonOnBoundary},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PointInPolygon(tt.p, poly); got != tt.want {
+				t.Errorf("PointInPolygon() = %v, want %v", got, tt.want)
+			}
+			if got := PointInPolygon(tt.p, reversePoints(poly)); got != tt.want {
+				t.Errorf("PointInPolygon() with clockwise polygon = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := PolygonArea2(poly); got != 24 {
+		t.Errorf("PolygonArea2() = %v, want %v", got, 24)
+	}
+}
+
+// reversePoints は、テスト用にpointsを逆順にしたsliceを返します.
+func reversePoints(points []Point) []Point {
+	res := make([]Point, len(points))
+	for i, p := range points {
+		res[len(points)-1-i] = p
+	}
+	return res
+}
+
+func randomPoints(r *rand.Rand, n, max int) []Point {
+	points := make([]Point, n)
+	for i := range points {
+		points[i] = Point{X: r.Intn(2*max+1) - max, Y: r.Intn(2*max+1) - max}
+	}
+	return points
+}
+
+func TestConvexHull(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []Point
+		want   []Point
+	}{
+		{
+			name:   "ConvexHull",
+			points: []Point{{0, 0}, {2, 0}, {1, 1}, {2, 2}, {0, 2}, {1, 0}, {1, 2}},
+			want:   []Point{{0, 0}, {2, 0}, {2, 2}, {0, 2}},
+		},
+		{
+			name:   "ConvexHull",
+			points: []Point{{3, 3}, {1, 1}, {2, 2}, {1, 1}},
+			want:   []Point{{1, 1}, {3, 3}},
+		},
+		{
+			// (2, 2)は辺上の点
+			name:   "ConvexHull",
+			points: []Point{{0, 0}, {4, 0}, {0, 4}, {1, 1}, {2, 2}, {1, 0}},
+			want:   []Point{{0, 0}, {4, 0}, {0, 4}},
+		},
+		{
+			name:   "ConvexHull",
+			points: []Point{{0, -2}, {2, 0}, {1, 2}, {-1, 2}, {-2, 0}, {0, 0}},
+			want:   []Point{{-2, 0}, {0, -2}, {2, 0}, {1, 2}, {-1, 2}},
+		},
+		{
+			name:   "ConvexHull",
+			points: []Point{{0, 3}, {0, 1}, {0, 2}},
+			want:   []Point{{0, 1}, {0, 3}},
+		},
+		{
+			name:   "ConvexHull",
+			points: []Point{{1, 1}, {1, 1}},
+			want:   []Point{{1, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvexHull(tt.points); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvexHull() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+}
+
+func TestClosestPairAndFarthestPair(t *testing.T) {
+	tests := []struct {
+		name         string
+		points       []Point
+		wantClosest  int
+		wantFarthest int
+	}{
+		{
+			name:         "ClosestPairAndFarthestPair",
+			points:       []Point{{0, 0}, {3, 4}, {10, 0}, {3, 5}},
+			wantClosest:  1,
+			wantFarthest: 100,
+		},
+		{
+			// 同じ点を含む
+			name:         "ClosestPairAndFarthestPair",
+			points:       []Point{{1, 1}, {5, 5}, {1, 1}},
+			wantClosest:  0,
+			wantFarthest: 32,
+		},
+		{
+			// 全ての点が一直線上にある
+			name:         "ClosestPairAndFarthestPair",
+			points:       []Point{{0, 0}, {1, 0}, {3, 0}, {6, 0}},
+			wantClosest:  1,
+			wantFarthest: 36,
+		},
+		{
+			name:         "ClosestPairAndFarthestPair",
+			points:       []Point{{-1000, -1000}, {1000, 1000}},
+			wantClosest:  8000000,
+			wantFarthest: 8000000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 距離が等しい組が複数ある場合があるため、indexではなく距離を比較する
+			ci, cj := MustClosestPair(tt.points)
+			if got := tt.points[ci].Sub(tt.points[cj]).Norm2(); ci == cj || got != tt.wantClosest {
+				t.Errorf("ClosestPair() = (%d, %d) with distance %d, want %d", ci, cj, got, tt.wantClosest)
+			}
+			fi, fj := MustFarthestPair(tt.points)
+			if got := tt.points[fi].Sub(tt.points[fj]).Norm2(); got != tt.wantFarthest {
+				t.Errorf("FarthestPair() = (%d, %d) with distance %d, want %d", fi, fj, got, tt.wantFarthest)
+			}
+		})
+	}
+	if _, _, err := ClosestPair([]Point{{0, 0}}); err == nil {
+		t.Errorf("ClosestPair() error = nil, want error")
+	}
+}
+
+func TestClosestPairAndFarthestPair_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		points := randomPoints(r, 2+r.Intn(60), 1+r.Intn(1000))
+		minD, maxD := math.MaxInt64, -1
+		for a := range points {
+			for b := a + 1; b < len(points); b++ {
+				d := points[a].Sub(points[b]).Norm2()
+				minD = MustMinInt(minD, d)
+				maxD = MustMaxInt(maxD, d)
+			}
+		}
+		ci, cj := MustClosestPair(points)
+		if got := points[ci].Sub(points[cj]).Norm2(); ci == cj || got != minD {
+			t.Fatalf("ClosestPair(%v) = (%d, %d) with distance %d, want %d", points, ci, cj, got, minD)
+		}
+		fi, fj := MustFarthestPair(points)
+		if got := points[fi].Sub(points[fj]).Norm2(); got != maxD {
+			t.Fatalf("FarthestPair(%v) = (%d, %d) with distance %d, want %d", points, fi, fj, got, maxD)
+		}
+	}
+}
+
+func TestCircleIntersection(t *testing.T) {
+	const eps = 1e-9
+	tests := []struct {
+		name    string
+		c1, c2  Circle
+		want    []PointF
+		wantErr bool
+	}{
+		{
+			name: "CircleIntersection",
+			c1:   Circle{C: PointF{0, 0}, R: 5},
+			c2:   Circle{C: PointF{8, 0}, R: 5},
+			want: []PointF{{4, 3}, {4, -3}},
+		},
+		{
+			// 外接する
+			name: "CircleIntersection",
+			c1:   Circle{C: PointF{0, 0}, R: 1},
+			c2:   Circle{C: PointF{0.3, 0.4}, R: 1.0 / 2},
+			want: []PointF{{0.6, 0.8}},
+		},
+		{
+			// 内接する
+			name: "CircleIntersection",
+			c1:   Circle{C: PointF{0, 0}, R: 2},
+			c2:   Circle{C: PointF{1, 0}, R: 1},
+			want: []PointF{{2, 0}},
+		},
+		{
+			name: "CircleIntersection",
+			c1:   Circle{C: PointF{0, 0}, R: 1},
+			c2:   Circle{C: PointF{3, 0}, R: 1},
+			want: []PointF{},
+		},
+		{
+			name: "CircleIntersection",
+			c1:   Circle{C: PointF{0, 0}, R: 3},
+			c2:   Circle{C: PointF{0.5, 0}, R: 1},
+			want: []PointF{},
+		},
+		{
+			name:    "CircleIntersection",
+			c1:      Circle{C: PointF{1, 1}, R: 2},
+			c2:      Circle{C: PointF{1, 1}, R: 2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CircleIntersection(tt.c1, tt.c2, eps)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CircleIntersection() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("CircleIntersection() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].Sub(tt.want[i]).Abs() > 1e-6 {
+					t.Errorf("CircleIntersection() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
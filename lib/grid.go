@@ -0,0 +1,181 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Dir4 は、上下左右の4近傍への移動量({行, 列})です.
+var Dir4 = [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}}
+
+// Dir8 は、斜めを含む8近傍への移動量({行, 列})です.
+var Dir8 = [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}, {-1, -1}, {-1, 1}, {1, -1}, {1, 1}}
+
+// Grid は、迷路などの一文字ずつのマスからなる二次元グリッドを表します.
+// Wallと一致するマスは壁として扱い、移動できません.
+type Grid struct {
+	Cells [][]string
+	H, W  int
+	Wall  string
+}
+
+// NewGrid は、Input.ReadAsStringGridFromで得られる二次元sliceから、"#"を壁とするGridを生成します.
+func NewGrid(cells [][]string) (*Grid, error) {
+	if len(cells) == 0 {
+		return nil, errors.New("empty grid is given")
+	}
+	w := len(cells[0])
+	for i, line := range cells {
+		if len(line) != w {
+			return nil, fmt.Errorf("%dth line length(%d) is different from first line length(%d)", i, len(line), w)
+		}
+	}
+	return &Grid{Cells: cells, H: len(cells), W: w, Wall: "#"}, nil
+}
+
+// InBounds は、(r, c)がグリッドの範囲内かを返します.
+func (g *Grid) InBounds(r, c int) bool {
+	return 0 <= r && r < g.H && 0 <= c && c < g.W
+}
+
+// IsWall は、(r, c)が壁であるかを返します. 範囲外は壁として扱います.
+func (g *Grid) IsWall(r, c int) bool {
+	return !g.InBounds(r, c) || g.Cells[r][c] == g.Wall
+}
+
+// Find は、sと一致する全てのマスの{行, 列}を行優先の順で返します.
+func (g *Grid) Find(s string) (positions [][2]int) {
+	for r, line := range g.Cells {
+		for c, cell := range line {
+			if cell == s {
+				positions = append(positions, [2]int{r, c})
+			}
+		}
+	}
+	return
+}
+
+// EachNeighbor4 は、(r, c)の上下左右のマスのうちグリッドの範囲内のものに対してfを呼び出します.
+func (g *Grid) EachNeighbor4(r, c int, f func(nr, nc int)) {
+	g.eachNeighbor(r, c, Dir4, f)
+}
+
+// EachNeighbor8 は、(r, c)の8近傍のマスのうちグリッドの範囲内のものに対してfを呼び出します.
+func (g *Grid) EachNeighbor8(r, c int, f func(nr, nc int)) {
+	g.eachNeighbor(r, c, Dir8, f)
+}
+
+func (g *Grid) eachNeighbor(r, c int, dirs [][2]int, f func(nr, nc int)) {
+	for _, d := range dirs {
+		nr, nc := r+d[0], c+d[1]
+		if g.InBounds(nr, nc) {
+			f(nr, nc)
+		}
+	}
+}
+
+// newDistMap は、全てのマスが-1で初期化された距離の二次元sliceを返します.
+func (g *Grid) newDistMap() [][]int {
+	return New2DimIntSliceWithInitialValue(g.H, g.W, -1)
+}
+
+// BFS は、(sr, sc)から壁を通らずに上下左右へ移動する場合の各マスへの最短距離を返します.
+// 到達できないマスは-1になります. 計算量はO(HW)です.
+func (g *Grid) BFS(sr, sc int) [][]int {
+	return g.MultiSourceBFS([][2]int{{sr, sc}})
+}
+
+// MultiSourceBFS は、startsのいずれかのマスから壁を通らずに上下左右へ移動する場合の各マスへの最短距離を返します.
+// 到達できないマスは-1になります. 壁や範囲外のマスはstartsに含まれていても無視されます.
+func (g *Grid) MultiSourceBFS(starts [][2]int) [][]int {
+	dist := g.newDistMap()
+	queue := make([][2]int, 0, g.H*g.W)
+	for _, s := range starts {
+		if g.IsWall(s[0], s[1]) || dist[s[0]][s[1]] == 0 {
+			continue
+		}
+		dist[s[0]][s[1]] = 0
+		queue = append(queue, s)
+	}
+	for head := 0; head < len(queue); head++ {
+		r, c := queue[head][0], queue[head][1]
+		g.EachNeighbor4(r, c, func(nr, nc int) {
+			if dist[nr][nc] >= 0 || g.Cells[nr][nc] == g.Wall {
+				return
+			}
+			dist[nr][nc] = dist[r][c] + 1
+			queue = append(queue, [2]int{nr, nc})
+		})
+	}
+	return dist
+}
+
+// ZeroOneBFS は、(sr, sc)から上下左右へ移動する場合の各マスへの最小コストを返します.
+// 移動のコストはcostが返す0か1で、負の値を返した移動は行いません. 壁を壊す問題のように、壁の扱いはcostで決めます.
+// 到達できないマスは-1になります. BFSと同様に、(sr, sc)が壁または範囲外の場合は全てのマスが-1になります. 計算量はO(HW)です.
+func (g *Grid) ZeroOneBFS(sr, sc int, cost func(r, c, nr, nc int) int) [][]int {
+	dist := g.newDistMap()
+	if g.IsWall(sr, sc) {
+		return dist
+	}
+	done := New2DimBoolSlice(g.H, g.W)
+	size := g.H*g.W*4 + 2
+	deque := make([][2]int, size)
+	head, tail := 0, 1
+	deque[0] = [2]int{sr, sc}
+	dist[sr][sc] = 0
+	for head != tail {
+		r, c := deque[head][0], deque[head][1]
+		head = (head + 1) % size
+		if done[r][c] {
+			continue
+		}
+		done[r][c] = true
+		g.EachNeighbor4(r, c, func(nr, nc int) {
+			w := cost(r, c, nr, nc)
+			if w < 0 || done[nr][nc] {
+				return
+			}
+			nd := dist[r][c] + w
+			if dist[nr][nc] >= 0 && dist[nr][nc] <= nd {
+				return
+			}
+			dist[nr][nc] = nd
+			if w == 0 {
+				head = (head - 1 + size) % size
+				deque[head] = [2]int{nr, nc}
+			} else {
+				deque[tail] = [2]int{nr, nc}
+				tail = (tail + 1) % size
+			}
+		})
+	}
+	return dist
+}
+
+// LabelRegions は、壁でないマスを上下左右に繋がった領域ごとに0始まりの番号で塗り分けた結果と、領域の数を返します.
+// 壁のマスは-1になります. 番号は行優先で最初に現れたマスの順に振られます.
+func (g *Grid) LabelRegions() (labels [][]int, num int) {
+	labels = g.newDistMap()
+	var queue [][2]int
+	for r := 0; r < g.H; r++ {
+		for c := 0; c < g.W; c++ {
+			if labels[r][c] >= 0 || g.Cells[r][c] == g.Wall {
+				continue
+			}
+			labels[r][c] = num
+			queue = append(queue[:0], [2]int{r, c})
+			for head := 0; head < len(queue); head++ {
+				g.EachNeighbor4(queue[head][0], queue[head][1], func(nr, nc int) {
+					if labels[nr][nc] >= 0 || g.Cells[nr][nc] == g.Wall {
+						return
+					}
+					labels[nr][nc] = num
+					queue = append(queue, [2]int{nr, nc})
+				})
+			}
+			num++
+		}
+	}
+	return
+}
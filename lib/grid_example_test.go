@@ -0,0 +1,30 @@
+package lib
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+func ExampleGrid_BFS() {
+	// abc007 C 幅優先探索
+	reader := bufio.NewReader(strings.NewReader(`7 8
+2 2
+4 5
+########
+#......#
+#.######
+#..#...#
+#..##..#
+##.....#
+########
+`))
+	input := MustNewInputFromReader(reader)
+	sr, sc := input.MustGetFirstAndSecondIntValue(1)
+	gr, gc := input.MustGetFirstAndSecondIntValue(2)
+	g := MustNewGrid(input.MustReadAsStringGridFrom(3))
+	fmt.Println(g.BFS(sr-1, sc-1)[gr-1][gc-1])
+
+	// Output:
+	// 11
+}
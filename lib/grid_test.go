@@ -0,0 +1,224 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func toStringGrid(lines ...string) (grid [][]string) {
+	for _, line := range lines {
+		var row []string
+		for _, r := range line {
+			row = append(row, string(r))
+		}
+		grid = append(grid, row)
+	}
+	return
+}
+
+func TestNewGrid(t *testing.T) {
+	tests := []struct {
+		name    string
+		cells   [][]string
+		wantH   int
+		wantW   int
+		wantErr bool
+	}{
+		{
+			name:  "NewGrid",
+			cells: toStringGrid("#..", "..#"),
+			wantH: 2,
+			wantW: 3,
+		},
+		{
+			name:    "NewGrid",
+			cells:   toStringGrid("#..", ".#"),
+			wantErr: true,
+		},
+		{
+			name:    "NewGrid",
+			cells:   nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewGrid(tt.cells)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewGrid() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.H != tt.wantH || got.W != tt.wantW {
+				t.Errorf("NewGrid() size = (%v, %v), want (%v, %v)", got.H, got.W, tt.wantH, tt.wantW)
+			}
+		})
+	}
+}
+
+func TestGrid_EachNeighbor(t *testing.T) {
+	tests := []struct {
+		name  string
+		r     int
+		c     int
+		want4 [][2]int
+		want8 [][2]int
+	}{
+		{
+			name:  "Grid_EachNeighbor",
+			r:     0,
+			c:     0,
+			want4: [][2]int{{1, 0}, {0, 1}},
+			want8: [][2]int{{1, 0}, {0, 1}, {1, 1}},
+		},
+		{
+			name:  "Grid_EachNeighbor",
+			r:     1,
+			c:     2,
+			want4: [][2]int{{0, 2}, {1, 1}},
+			want8: [][2]int{{0, 2}, {1, 1}, {0, 1}},
+		},
+	}
+	g := MustNewGrid(toStringGrid("...", "..."))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got4, got8 [][2]int
+			g.EachNeighbor4(tt.r, tt.c, func(nr, nc int) {
+				got4 = append(got4, [2]int{nr, nc})
+			})
+			g.EachNeighbor8(tt.r, tt.c, func(nr, nc int) {
+				got8 = append(got8, [2]int{nr, nc})
+			})
+			if !reflect.DeepEqual(got4, tt.want4) {
+				t.Errorf("Grid.EachNeighbor4() = %v, want %v", got4, tt.want4)
+			}
+			if !reflect.DeepEqual(got8, tt.want8) {
+				t.Errorf("Grid.EachNeighbor8() = %v, want %v", got8, tt.want8)
+			}
+		})
+	}
+}
+
+func TestGrid_MultiSourceBFS(t *testing.T) {
+	tests := []struct {
+		name   string
+		cells  [][]string
+		starts [][2]int
+		want   [][]int
+	}{
+		{
+			name:   "Grid_MultiSourceBFS",
+			cells:  toStringGrid("..#.", "#...", "...#"),
+			starts: [][2]int{{0, 0}},
+			want:   [][]int{{0, 1, -1, 5}, {-1, 2, 3, 4}, {4, 3, 4, -1}},
+		},
+		{
+			name:   "Grid_MultiSourceBFS",
+			cells:  toStringGrid("..#.", "#...", "...#"),
+			starts: [][2]int{{0, 0}, {2, 0}, {0, 3}, {0, 2}},
+			want:   [][]int{{0, 1, -1, 0}, {-1, 2, 2, 1}, {0, 1, 2, -1}},
+		},
+		{
+			name:   "Grid_MultiSourceBFS",
+			cells:  toStringGrid(".#.", ".#."),
+			starts: [][2]int{{0, 0}},
+			want:   [][]int{{0, -1, -1}, {1, -1, -1}},
+		},
+		{
+			name:   "壁から開始する場合は全て到達不可能",
+			cells:  toStringGrid("#.", ".."),
+			starts: [][2]int{{0, 0}},
+			want:   [][]int{{-1, -1}, {-1, -1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := MustNewGrid(tt.cells)
+			if got := g.MultiSourceBFS(tt.starts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Grid.MultiSourceBFS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrid_ZeroOneBFS(t *testing.T) {
+	tests := []struct {
+		name   string
+		cells  [][]string
+		sr, sc int
+		want   [][]int
+	}{
+		{
+			// 壁を壊すコストを1とする
+			name:  "Grid_ZeroOneBFS",
+			cells: toStringGrid(".#.", "##.", "..#"),
+			want:  [][]int{{0, 1, 1}, {1, 2, 1}, {1, 1, 2}},
+		},
+		{
+			name:  "Grid_ZeroOneBFS",
+			cells: toStringGrid("...", "...", "..."),
+			want:  [][]int{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}},
+		},
+		{
+			name:  "壁から開始する場合は全て到達不可能",
+			cells: toStringGrid("#.", ".."),
+			want:  [][]int{{-1, -1}, {-1, -1}},
+		},
+		{
+			name:  "範囲外から開始する場合は全て到達不可能",
+			cells: toStringGrid("..", ".."),
+			sr:    2,
+			sc:    0,
+			want:  [][]int{{-1, -1}, {-1, -1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := MustNewGrid(tt.cells)
+			got := g.ZeroOneBFS(tt.sr, tt.sc, func(r, c, nr, nc int) int {
+				if g.IsWall(nr, nc) {
+					return 1
+				}
+				return 0
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Grid.ZeroOneBFS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrid_LabelRegions(t *testing.T) {
+	tests := []struct {
+		name       string
+		cells      [][]string
+		wantLabels [][]int
+		wantNum    int
+	}{
+		{
+			name:       "Grid_LabelRegions",
+			cells:      toStringGrid("..#.", "##..", ".#.#"),
+			wantLabels: [][]int{{0, 0, -1, 1}, {-1, -1, 1, 1}, {2, -1, 1, -1}},
+			wantNum:    3,
+		},
+		{
+			name:       "Grid_LabelRegions",
+			cells:      toStringGrid("##"),
+			wantLabels: [][]int{{-1, -1}},
+			wantNum:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotLabels, gotNum := MustNewGrid(tt.cells).LabelRegions()
+			if !reflect.DeepEqual(gotLabels, tt.wantLabels) {
+				t.Errorf("Grid.LabelRegions() gotLabels = %v, want %v", gotLabels, tt.wantLabels)
+			}
+			if gotNum != tt.wantNum {
+				t.Errorf("Grid.LabelRegions() gotNum = %v, want %v", gotNum, tt.wantNum)
+			}
+		})
+	}
+}
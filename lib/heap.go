@@ -0,0 +1,361 @@
+package lib
+
+// HeapAAA は、二分ヒープによる優先度付きキューです. container/heapと異なり、heap.Interfaceを実装する必要がありません.
+// 空のヒープに対するPopとTopはpanicします.
+type HeapAAA struct {
+	data  []AAA
+	isMax bool
+}
+
+// NewMinHeapAAA は、最小の値から取り出すヒープを返します. 初期値valuesからの構築の計算量はO(N)です.
+func NewMinHeapAAA(values ...AAA) *HeapAAA {
+	return newHeapAAA(values, false)
+}
+
+// NewMaxHeapAAA は、最大の値から取り出すヒープを返します. 初期値valuesからの構築の計算量はO(N)です.
+func NewMaxHeapAAA(values ...AAA) *HeapAAA {
+	return newHeapAAA(values, true)
+}
+
+func newHeapAAA(values []AAA, isMax bool) *HeapAAA {
+	h := &HeapAAA{data: append([]AAA{}, values...), isMax: isMax}
+	for i := len(h.data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+func (h *HeapAAA) less(i, j int) bool {
+	if h.isMax {
+		return h.data[i] > h.data[j]
+	}
+	return h.data[i] < h.data[j]
+}
+
+func (h *HeapAAA) up(i int) {
+	for i > 0 {
+		p := (i - 1) / 2
+		if !h.less(i, p) {
+			return
+		}
+		h.data[i], h.data[p] = h.data[p], h.data[i]
+		i = p
+	}
+}
+
+func (h *HeapAAA) down(i int) {
+	for {
+		c := 2*i + 1
+		if c >= len(h.data) {
+			return
+		}
+		if c+1 < len(h.data) && h.less(c+1, c) {
+			c++
+		}
+		if !h.less(c, i) {
+			return
+		}
+		h.data[i], h.data[c] = h.data[c], h.data[i]
+		i = c
+	}
+}
+
+// Len は、ヒープの要素数を返します.
+func (h *HeapAAA) Len() int {
+	return len(h.data)
+}
+
+// Push は、vをヒープに追加します. 計算量はO(log N)です.
+func (h *HeapAAA) Push(v AAA) {
+	h.data = append(h.data, v)
+	h.up(len(h.data) - 1)
+}
+
+// Top は、次にPopで取り出される値を返します.
+func (h *HeapAAA) Top() AAA {
+	return h.data[0]
+}
+
+// Pop は、最小(最大ヒープの場合は最大)の値を取り出して返します. 計算量はO(log N)です.
+func (h *HeapAAA) Pop() AAA {
+	top := h.data[0]
+	last := len(h.data) - 1
+	h.data[0] = h.data[last]
+	h.data = h.data[:last]
+	h.down(0)
+	return top
+}
+
+// PairHeapAAA は、(優先度, 値)の組を辞書順に取り出す優先度付きキューです. 値には頂点番号などを格納します.
+// 空のヒープに対するPopとTopはpanicします.
+type PairHeapAAA struct {
+	priorities []AAA
+	values     []int
+	isMax      bool
+}
+
+// NewPairMinHeapAAA は、(優先度, 値)が辞書順で最小の組から取り出すヒープを返します.
+// ダイクストラ法などで(距離, 頂点)を管理する際に利用できます.
+func NewPairMinHeapAAA() *PairHeapAAA {
+	return &PairHeapAAA{}
+}
+
+// NewPairMaxHeapAAA は、(優先度, 値)が辞書順で最大の組から取り出すヒープを返します.
+func NewPairMaxHeapAAA() *PairHeapAAA {
+	return &PairHeapAAA{isMax: true}
+}
+
+func (h *PairHeapAAA) less(i, j int) bool {
+	pi, pj := h.priorities[i], h.priorities[j]
+	if pi == pj {
+		if h.isMax {
+			return h.values[i] > h.values[j]
+		}
+		return h.values[i] < h.values[j]
+	}
+	if h.isMax {
+		return pi > pj
+	}
+	return pi < pj
+}
+
+func (h *PairHeapAAA) swap(i, j int) {
+	h.priorities[i], h.priorities[j] = h.priorities[j], h.priorities[i]
+	h.values[i], h.values[j] = h.values[j], h.values[i]
+}
+
+// Len は、ヒープの要素数を返します.
+func (h *PairHeapAAA) Len() int {
+	return len(h.values)
+}
+
+// Push は、(priority, value)の組をヒープに追加します. 計算量はO(log N)です.
+func (h *PairHeapAAA) Push(priority AAA, value int) {
+	h.priorities = append(h.priorities, priority)
+	h.values = append(h.values, value)
+	for i := len(h.values) - 1; i > 0; {
+		p := (i - 1) / 2
+		if !h.less(i, p) {
+			break
+		}
+		h.swap(i, p)
+		i = p
+	}
+}
+
+// Top は、次にPopで取り出される組を返します.
+func (h *PairHeapAAA) Top() (priority AAA, value int) {
+	return h.priorities[0], h.values[0]
+}
+
+// Pop は、辞書順で最小(最大ヒープの場合は最大)の組を取り出して返します. 計算量はO(log N)です.
+func (h *PairHeapAAA) Pop() (priority AAA, value int) {
+	priority, value = h.Top()
+	last := len(h.values) - 1
+	h.swap(0, last)
+	h.priorities, h.values = h.priorities[:last], h.values[:last]
+	for i := 0; ; {
+		c := 2*i + 1
+		if c >= last {
+			break
+		}
+		if c+1 < last && h.less(c+1, c) {
+			c++
+		}
+		if !h.less(c, i) {
+			break
+		}
+		h.swap(i, c)
+		i = c
+	}
+	return
+}
+
+// IndexedHeapAAA は、0からn-1までのkeyそれぞれに優先度を持たせ、優先度が最小のkeyから取り出すヒープです.
+// 格納済みのkeyの優先度をO(log N)で変更できるため、ダイクストラ法でヒープの大きさを頂点数以下に抑えられます.
+// 空のヒープに対するPopとTopはpanicします.
+type IndexedHeapAAA struct {
+	keys []int
+	pos  []int
+	prio []AAA
+}
+
+// NewIndexedHeapAAA は、keyとして0からn-1までを扱える空のヒープを返します.
+func NewIndexedHeapAAA(n int) *IndexedHeapAAA {
+	pos := make([]int, n)
+	for i := range pos {
+		pos[i] = -1
+	}
+	return &IndexedHeapAAA{pos: pos, prio: make([]AAA, n)}
+}
+
+func (h *IndexedHeapAAA) less(i, j int) bool {
+	return h.prio[h.keys[i]] < h.prio[h.keys[j]]
+}
+
+func (h *IndexedHeapAAA) swap(i, j int) {
+	h.keys[i], h.keys[j] = h.keys[j], h.keys[i]
+	h.pos[h.keys[i]] = i
+	h.pos[h.keys[j]] = j
+}
+
+func (h *IndexedHeapAAA) up(i int) {
+	for i > 0 {
+		p := (i - 1) / 2
+		if !h.less(i, p) {
+			return
+		}
+		h.swap(i, p)
+		i = p
+	}
+}
+
+func (h *IndexedHeapAAA) down(i int) {
+	for {
+		c := 2*i + 1
+		if c >= len(h.keys) {
+			return
+		}
+		if c+1 < len(h.keys) && h.less(c+1, c) {
+			c++
+		}
+		if !h.less(c, i) {
+			return
+		}
+		h.swap(i, c)
+		i = c
+	}
+}
+
+// Len は、ヒープに格納されているkeyの数を返します.
+func (h *IndexedHeapAAA) Len() int {
+	return len(h.keys)
+}
+
+// Contains は、keyがヒープに格納されているかを返します.
+func (h *IndexedHeapAAA) Contains(key int) bool {
+	return h.pos[key] >= 0
+}
+
+// Priority は、ヒープに格納されているkeyの優先度を返します. keyが格納されていない場合の値は不定です.
+func (h *IndexedHeapAAA) Priority(key int) AAA {
+	return h.prio[key]
+}
+
+// Set は、keyの優先度をpriorityにします. keyが格納されていない場合は追加します. 計算量はO(log N)です.
+func (h *IndexedHeapAAA) Set(key int, priority AAA) {
+	if h.pos[key] < 0 {
+		h.keys = append(h.keys, key)
+		h.pos[key] = len(h.keys) - 1
+		h.prio[key] = priority
+		h.up(h.pos[key])
+		return
+	}
+	old := h.prio[key]
+	h.prio[key] = priority
+	if priority < old {
+		h.up(h.pos[key])
+	} else {
+		h.down(h.pos[key])
+	}
+}
+
+// DecreaseKey は、keyが格納されていないか、priorityが現在の優先度より小さい場合に、keyの優先度をpriorityにしてtrueを返します.
+// それ以外の場合は何もせずにfalseを返します. 計算量はO(log N)です.
+func (h *IndexedHeapAAA) DecreaseKey(key int, priority AAA) bool {
+	if h.pos[key] >= 0 && h.prio[key] <= priority {
+		return false
+	}
+	h.Set(key, priority)
+	return true
+}
+
+// Top は、優先度が最小のkeyとその優先度を返します.
+func (h *IndexedHeapAAA) Top() (key int, priority AAA) {
+	return h.keys[0], h.prio[h.keys[0]]
+}
+
+// Pop は、優先度が最小のkeyを取り除き、そのkeyと優先度を返します. 計算量はO(log N)です.
+func (h *IndexedHeapAAA) Pop() (key int, priority AAA) {
+	key, priority = h.Top()
+	h.Remove(key)
+	return
+}
+
+// Remove は、keyをヒープから取り除きます. keyが格納されていない場合は何もしません. 計算量はO(log N)です.
+func (h *IndexedHeapAAA) Remove(key int) {
+	i := h.pos[key]
+	if i < 0 {
+		return
+	}
+	last := len(h.keys) - 1
+	h.swap(i, last)
+	h.keys = h.keys[:last]
+	h.pos[key] = -1
+	if i < last {
+		h.down(i)
+		h.up(i)
+	}
+}
+
+// MedianAAA は、値を追加しながら中央値を管理する構造体です. 中央値以下の値を最大ヒープに、それ以外を最小ヒープに保持します.
+type MedianAAA struct {
+	lower, upper       *HeapAAA
+	lowerSum, upperSum AAA
+}
+
+// NewMedianAAA は、空のMedianAAAを返します.
+func NewMedianAAA() *MedianAAA {
+	return &MedianAAA{lower: NewMaxHeapAAA(), upper: NewMinHeapAAA()}
+}
+
+// Len は、追加された値の数を返します.
+func (m *MedianAAA) Len() int {
+	return m.lower.Len() + m.upper.Len()
+}
+
+// Add は、vを追加します. 計算量はO(log N)です.
+func (m *MedianAAA) Add(v AAA) {
+	if m.lower.Len() > 0 && v > m.lower.Top() {
+		m.upper.Push(v)
+		m.upperSum += v
+	} else {
+		m.lower.Push(v)
+		m.lowerSum += v
+	}
+	// lowerの要素数がupperと等しいか1つ多い状態を保つ
+	if m.lower.Len() > m.upper.Len()+1 {
+		v := m.lower.Pop()
+		m.lowerSum -= v
+		m.upper.Push(v)
+		m.upperSum += v
+	} else if m.lower.Len() < m.upper.Len() {
+		v := m.upper.Pop()
+		m.upperSum -= v
+		m.lower.Push(v)
+		m.lowerSum += v
+	}
+}
+
+// Median は、中央値を返します. 値の数が偶数の場合は、中央の2つの値のうち小さい方を返します. 値が1つもない場合はpanicします.
+func (m *MedianAAA) Median() AAA {
+	return m.lower.Top()
+}
+
+// Medians は、中央の2つの値を小さい順に返します. 値の数が奇数の場合は、どちらも中央値になります. 値が1つもない場合はpanicします.
+func (m *MedianAAA) Medians() (lo, hi AAA) {
+	if m.lower.Len() > m.upper.Len() {
+		return m.lower.Top(), m.lower.Top()
+	}
+	return m.lower.Top(), m.upper.Top()
+}
+
+// AbsDeviationSum は、追加された全ての値と中央値との差の絶対値の和を返します. これはΣ|v-x|の最小値と一致します.
+// 値が1つもない場合は0を返します.
+func (m *MedianAAA) AbsDeviationSum() AAA {
+	if m.Len() == 0 {
+		return 0
+	}
+	med := m.Median()
+	return med*AAA(m.lower.Len()) - m.lowerSum + m.upperSum - med*AAA(m.upper.Len())
+}
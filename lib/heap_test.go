@@ -0,0 +1,222 @@
+package lib
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestHeapAAA(t *testing.T) {
+	tests := []struct {
+		name   string
+		heap   *HeapAAA
+		values []AAA
+		want   []AAA
+	}{
+		{
+			name:   "min heap",
+			heap:   NewMinHeapAAA(5, 1, 4, 1, 3),
+			values: []AAA{2, -1},
+			want:   []AAA{-1, 1, 1, 2, 3, 4, 5},
+		},
+		{
+			name:   "max heap",
+			heap:   NewMaxHeapAAA(5, 1, 4, 1, 3),
+			values: []AAA{2, -1},
+			want:   []AAA{5, 4, 3, 2, 1, 1, -1},
+		},
+		{
+			name:   "empty min heap",
+			heap:   NewMinHeapAAA(),
+			values: []AAA{3, 3, 2},
+			want:   []AAA{2, 3, 3},
+		},
+		{
+			name:   "empty heap",
+			heap:   NewMaxHeapAAA(),
+			values: nil,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range tt.values {
+				tt.heap.Push(v)
+			}
+			var got []AAA
+			for tt.heap.Len() > 0 {
+				top := tt.heap.Top()
+				if v := tt.heap.Pop(); v != top {
+					t.Fatalf("Pop() = %v, but Top() = %v", v, top)
+				}
+				got = append(got, top)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPairHeapAAA(t *testing.T) {
+	type pair struct {
+		priority AAA
+		value    int
+	}
+	pairs := []pair{{3, 1}, {1, 5}, {3, 0}, {2, 2}, {1, 4}}
+	tests := []struct {
+		name string
+		heap *PairHeapAAA
+		want []pair
+	}{
+		{
+			name: "min heap",
+			heap: NewPairMinHeapAAA(),
+			want: []pair{{1, 4}, {1, 5}, {2, 2}, {3, 0}, {3, 1}},
+		},
+		{
+			name: "max heap",
+			heap: NewPairMaxHeapAAA(),
+			want: []pair{{3, 1}, {3, 0}, {2, 2}, {1, 5}, {1, 4}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, p := range pairs {
+				tt.heap.Push(p.priority, p.value)
+			}
+			var got []pair
+			for tt.heap.Len() > 0 {
+				p, v := tt.heap.Pop()
+				got = append(got, pair{p, v})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexedHeapAAA(t *testing.T) {
+	h := NewIndexedHeapAAA(5)
+	h.Set(0, 5)
+	h.Set(1, 3)
+	h.Set(2, 8)
+	decreaseTests := []struct {
+		key      int
+		priority AAA
+		want     bool
+	}{
+		{key: 2, priority: 1, want: true},
+		{key: 0, priority: 7, want: false},
+		// 格納されていないkeyは追加される
+		{key: 3, priority: 4, want: true},
+	}
+	for _, tt := range decreaseTests {
+		if got := h.DecreaseKey(tt.key, tt.priority); got != tt.want {
+			t.Errorf("DecreaseKey(%d, %v) = %v, want %v", tt.key, tt.priority, got, tt.want)
+		}
+	}
+	// Setでは優先度を大きくすることもできる
+	h.Set(1, 9)
+	h.Remove(0)
+	h.Remove(4)
+	if h.Contains(0) || !h.Contains(1) || h.Priority(1) != 9 {
+		t.Errorf("Contains(0) = %v, Contains(1) = %v, Priority(1) = %v", h.Contains(0), h.Contains(1), h.Priority(1))
+	}
+
+	type item struct {
+		key      int
+		priority AAA
+	}
+	want := []item{{2, 1}, {3, 4}, {1, 9}}
+	var got []item
+	for h.Len() > 0 {
+		key, priority := h.Pop()
+		got = append(got, item{key, priority})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got = %v, want %v", got, want)
+	}
+}
+
+func TestIndexedHeapInt_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 30
+	h := NewIndexedHeapInt(n)
+	want := map[int]int{}
+	for i := 0; i < 300; i++ {
+		key, priority := r.Intn(n), r.Intn(100)
+		switch r.Intn(4) {
+		case 0:
+			h.Set(key, priority)
+			want[key] = priority
+		case 1:
+			old, ok := want[key]
+			updated := !ok || priority < old
+			if got := h.DecreaseKey(key, priority); got != updated {
+				t.Fatalf("DecreaseKey(%d, %d) = %v, want %v", key, priority, got, updated)
+			}
+			if updated {
+				want[key] = priority
+			}
+		case 2:
+			h.Remove(key)
+			delete(want, key)
+		case 3:
+			if len(want) == 0 {
+				continue
+			}
+			minPriority := 1 << 30
+			for _, p := range want {
+				if p < minPriority {
+					minPriority = p
+				}
+			}
+			key, priority := h.Pop()
+			if priority != minPriority || want[key] != priority {
+				t.Fatalf("Pop() = (%d, %d), want priority %d", key, priority, minPriority)
+			}
+			delete(want, key)
+		}
+		if h.Len() != len(want) {
+			t.Fatalf("Len() = %d, want %d", h.Len(), len(want))
+		}
+		for k := 0; k < n; k++ {
+			p, ok := want[k]
+			if h.Contains(k) != ok || (ok && h.Priority(k) != p) {
+				t.Fatalf("key %d: Contains() = %v, want %v", k, h.Contains(k), ok)
+			}
+		}
+	}
+}
+
+func TestMedianAAA(t *testing.T) {
+	tests := []struct {
+		v              AAA
+		wantLo, wantHi AAA
+		wantDev        AAA
+	}{
+		{v: 5, wantLo: 5, wantHi: 5, wantDev: 0},
+		{v: 1, wantLo: 1, wantHi: 5, wantDev: 4},
+		{v: 3, wantLo: 3, wantHi: 3, wantDev: 4},
+		{v: 3, wantLo: 3, wantHi: 3, wantDev: 4},
+		{v: -2, wantLo: 3, wantHi: 3, wantDev: 9},
+		{v: 10, wantLo: 3, wantHi: 3, wantDev: 16},
+		{v: 20, wantLo: 3, wantHi: 3, wantDev: 33},
+		{v: 30, wantLo: 3, wantHi: 5, wantDev: 60},
+	}
+	m := NewMedianAAA()
+	for _, tt := range tests {
+		m.Add(tt.v)
+		if got := m.Median(); got != tt.wantLo {
+			t.Errorf("Median() after Add(%v) = %v, want %v", tt.v, got, tt.wantLo)
+		}
+		if lo, hi := m.Medians(); lo != tt.wantLo || hi != tt.wantHi {
+			t.Errorf("Medians() after Add(%v) = (%v, %v), want (%v, %v)", tt.v, lo, hi, tt.wantLo, tt.wantHi)
+		}
+		if got := m.AbsDeviationSum(); got != tt.wantDev {
+			t.Errorf("AbsDeviationSum() after Add(%v) = %v, want %v", tt.v, got, tt.wantDev)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package lib
+
+import "fmt"
+
+// HLD は、木を重軽分解(Heavy-Light Decomposition)した結果を保持します.
+// 各頂点にはInの番号が振られ、木上のパスはO(logN)個、部分木は1個の連続した番号の区間に対応します.
+// セグメント木などと組み合わせることで、パスや部分木に対する取得・更新クエリを処理できます.
+type HLD struct {
+	// Parent は各頂点の親です. 根の親は-1です.
+	Parent []int
+	// Depth は根からの深さです.
+	Depth []int
+	// Size は部分木の頂点数です.
+	Size []int
+	// Head は、各頂点が属するheavy pathの最も根に近い頂点です.
+	Head []int
+	// In は、各頂点に割り当てられた番号です. 部分木vは[In[v], Out[v])に対応します.
+	In  []int
+	Out []int
+	// Order は、番号から頂点への対応です. Order[In[v]] == vです.
+	Order []int
+}
+
+// NewHLD は、AdjacencyListなどで得られる木の隣接リストを、rootを根として重軽分解します.
+// 辺の数がN-1でない場合や連結でない場合は、木ではないためエラーを返します.
+// 再帰を使わないため、深い木でも利用できます. 計算量はO(N)です.
+func NewHLD(list [][]int, root int) (*HLD, error) {
+	n := len(list)
+	if root < 0 || root >= n {
+		return nil, fmt.Errorf("root(%d) is out of range [0, %d)", root, n)
+	}
+	// 無向グラフの隣接リストでは各辺が2回現れるため、木であれば合計はちょうど2(n-1)になる
+	degreeSum := 0
+	for _, to := range list {
+		degreeSum += len(to)
+	}
+	if degreeSum != 2*(n-1) {
+		return nil, fmt.Errorf("given graph is not tree. edge num must be %d, but adjacency list has %d entries", n-1, degreeSum)
+	}
+	h := &HLD{
+		Parent: NewIntSliceWithInitialValue(n, -1),
+		Depth:  make([]int, n),
+		Size:   make([]int, n),
+		Head:   make([]int, n),
+		In:     make([]int, n),
+		Out:    make([]int, n),
+		Order:  make([]int, 0, n),
+	}
+
+	bfsOrder := make([]int, 0, n)
+	bfsOrder = append(bfsOrder, root)
+	visited := make([]bool, n)
+	visited[root] = true
+	for i := 0; i < len(bfsOrder); i++ {
+		v := bfsOrder[i]
+		for _, to := range list[v] {
+			if visited[to] {
+				continue
+			}
+			visited[to] = true
+			h.Parent[to] = v
+			h.Depth[to] = h.Depth[v] + 1
+			bfsOrder = append(bfsOrder, to)
+		}
+	}
+	if len(bfsOrder) != n {
+		return nil, fmt.Errorf("given graph is not connected tree. reachable nodes: %d/%d", len(bfsOrder), n)
+	}
+
+	heavy := NewIntSliceWithInitialValue(n, -1)
+	for i := n - 1; i >= 0; i-- {
+		v := bfsOrder[i]
+		h.Size[v]++
+		if p := h.Parent[v]; p >= 0 {
+			h.Size[p] += h.Size[v]
+			if heavy[p] < 0 || h.Size[heavy[p]] < h.Size[v] {
+				heavy[p] = v
+			}
+		}
+	}
+
+	h.Head[root] = root
+	stack := []int{root}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		h.In[v] = len(h.Order)
+		h.Order = append(h.Order, v)
+		for _, to := range list[v] {
+			if to == h.Parent[v] || to == heavy[v] {
+				continue
+			}
+			h.Head[to] = to
+			stack = append(stack, to)
+		}
+		if heavy[v] >= 0 {
+			h.Head[heavy[v]] = h.Head[v]
+			stack = append(stack, heavy[v])
+		}
+	}
+	for v := 0; v < n; v++ {
+		h.Out[v] = h.In[v] + h.Size[v]
+	}
+	return h, nil
+}
+
+// LCA は、uとvの最小共通祖先を返します. 計算量はO(logN)です.
+func (h *HLD) LCA(u, v int) int {
+	for h.Head[u] != h.Head[v] {
+		if h.Depth[h.Head[u]] > h.Depth[h.Head[v]] {
+			u = h.Parent[h.Head[u]]
+		} else {
+			v = h.Parent[h.Head[v]]
+		}
+	}
+	if h.Depth[u] < h.Depth[v] {
+		return u
+	}
+	return v
+}
+
+// Dist は、uとvの間の辺の数を返します.
+func (h *HLD) Dist(u, v int) int {
+	return h.Depth[u] + h.Depth[v] - 2*h.Depth[h.LCA(u, v)]
+}
+
+// PathRanges は、uとvを結ぶパスに対応する番号の半開区間[l, r)を返します.
+// edgeがtrueの場合は辺に値を持たせる場合の区間を返します. この場合、辺(親, 子)の値は子の番号で管理し、LCAは区間に含まれません.
+// 区間の順序はパス上の順序と一致しないため、可換な演算(和、最小値、最大値など)で利用してください.
+func (h *HLD) PathRanges(u, v int, edge bool) (ranges [][2]int) {
+	for h.Head[u] != h.Head[v] {
+		if h.Depth[h.Head[u]] < h.Depth[h.Head[v]] {
+			u, v = v, u
+		}
+		ranges = append(ranges, [2]int{h.In[h.Head[u]], h.In[u] + 1})
+		u = h.Parent[h.Head[u]]
+	}
+	if h.Depth[u] > h.Depth[v] {
+		u, v = v, u
+	}
+	l := h.In[u]
+	if edge {
+		l++
+	}
+	if l <= h.In[v] {
+		ranges = append(ranges, [2]int{l, h.In[v] + 1})
+	}
+	return
+}
+
+// SubtreeRange は、vの部分木に対応する番号の半開区間[l, r)を返します.
+// edgeがtrueの場合は、部分木に含まれる辺に対応する区間を返します.
+func (h *HLD) SubtreeRange(v int, edge bool) (l, r int) {
+	if edge {
+		return h.In[v] + 1, h.Out[v]
+	}
+	return h.In[v], h.Out[v]
+}
+
+// EdgeIndex は、隣接する頂点uとvを結ぶ辺に対応する番号を返します.
+func (h *HLD) EdgeIndex(u, v int) int {
+	if h.Depth[u] < h.Depth[v] {
+		return h.In[v]
+	}
+	return h.In[u]
+}
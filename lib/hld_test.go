@@ -0,0 +1,240 @@
+package lib
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// randomTree は、n頂点のランダムな木の隣接リストと、各頂点の親(根0の親は-1)を返します.
+func randomTree(r *rand.Rand, n int) (list [][]int, parent []int) {
+	var x, y []int
+	parent = []int{-1}
+	for v := 1; v < n; v++ {
+		p := r.Intn(v)
+		x, y = append(x, p), append(y, v)
+		parent = append(parent, p)
+	}
+	list = MustAdjacencyList(x, y, n)
+	return
+}
+
+// bruteForcePath は、親を辿ってuからvへのパス上の頂点を昇順で返します.
+func bruteForcePath(parent []int, u, v int) (path []int, lca int) {
+	ancestors := map[int]bool{}
+	for w := u; w >= 0; w = parent[w] {
+		ancestors[w] = true
+	}
+	lca = v
+	for !ancestors[lca] {
+		lca = parent[lca]
+	}
+	path = []int{lca}
+	for w := u; w != lca; w = parent[w] {
+		path = append(path, w)
+	}
+	for w := v; w != lca; w = parent[w] {
+		path = append(path, w)
+	}
+	sort.Ints(path)
+	return
+}
+
+func TestNewHLD(t *testing.T) {
+	type args struct {
+		x    []int
+		y    []int
+		n    int
+		root int
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantParent []int
+		wantHead   []int
+		wantIn     []int
+		wantErr    bool
+	}{
+		{
+			name: "heavy pathが一直線に並ぶ木",
+			args: args{
+				// 0 - 1 - 2 - 3, 1 - 4
+				x:    []int{0, 1, 2, 1},
+				y:    []int{1, 2, 3, 4},
+				n:    5,
+				root: 0,
+			},
+			wantParent: []int{-1, 0, 1, 2, 1},
+			wantHead:   []int{0, 0, 0, 0, 4},
+			wantIn:     []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "連結でないグラフ",
+			args: args{
+				x:    []int{0},
+				y:    []int{1},
+				n:    3,
+				root: 0,
+			},
+			wantErr: true,
+		},
+		{
+			name: "閉路を含む連結なグラフ",
+			args: args{
+				x:    []int{0, 1, 2, 2},
+				y:    []int{1, 2, 0, 3},
+				n:    4,
+				root: 0,
+			},
+			wantErr: true,
+		},
+		{
+			name: "範囲外のroot",
+			args: args{
+				x:    []int{0},
+				y:    []int{1},
+				n:    2,
+				root: 2,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := NewHLD(MustAdjacencyList(tt.args.x, tt.args.y, tt.args.n), tt.args.root)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewHLD() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(h.Parent, tt.wantParent) {
+				t.Errorf("HLD.Parent = %v, want %v", h.Parent, tt.wantParent)
+			}
+			if !reflect.DeepEqual(h.Head, tt.wantHead) {
+				t.Errorf("HLD.Head = %v, want %v", h.Head, tt.wantHead)
+			}
+			if !reflect.DeepEqual(h.In, tt.wantIn) {
+				t.Errorf("HLD.In = %v, want %v", h.In, tt.wantIn)
+			}
+		})
+	}
+}
+
+// newTestHLD は、以下の木を頂点0を根として重軽分解した結果を返します.
+//
+//	    0
+//	  / | \
+//	 1  2  3
+//	/ \     \
+//	4  5     6
+//	|
+//	7
+func newTestHLD() *HLD {
+	x := []int{0, 0, 0, 1, 1, 3, 4}
+	y := []int{1, 2, 3, 4, 5, 6, 7}
+	return MustNewHLD(MustAdjacencyList(x, y, 8), 0)
+}
+
+// hldVertices は、番号の区間rangesに含まれる頂点を昇順で返します.
+func hldVertices(h *HLD, ranges [][2]int) []int {
+	var vertices []int
+	for _, rng := range ranges {
+		for k := rng[0]; k < rng[1]; k++ {
+			vertices = append(vertices, h.Order[k])
+		}
+	}
+	sort.Ints(vertices)
+	return vertices
+}
+
+func TestHLD_PathRanges(t *testing.T) {
+	tests := []struct {
+		name          string
+		u, v          int
+		wantLCA       int
+		wantDist      int
+		wantVertices  []int
+		wantEdgeLower []int
+	}{
+		{
+			name: "同じ部分木内のパス", u: 7, v: 5,
+			wantLCA: 1, wantDist: 3, wantVertices: []int{1, 4, 5, 7}, wantEdgeLower: []int{4, 5, 7},
+		},
+		{
+			name: "根を通るパス", u: 7, v: 6,
+			wantLCA: 0, wantDist: 5, wantVertices: []int{0, 1, 3, 4, 6, 7}, wantEdgeLower: []int{1, 3, 4, 6, 7},
+		},
+		{
+			name: "祖先と子孫のパス", u: 4, v: 7,
+			wantLCA: 4, wantDist: 1, wantVertices: []int{4, 7}, wantEdgeLower: []int{7},
+		},
+		{
+			name: "同じ頂点", u: 2, v: 2,
+			wantLCA: 2, wantDist: 0, wantVertices: []int{2}, wantEdgeLower: nil,
+		},
+	}
+	h := newTestHLD()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := h.LCA(tt.u, tt.v); got != tt.wantLCA {
+				t.Errorf("HLD.LCA() = %d, want %d", got, tt.wantLCA)
+			}
+			if got := h.Dist(tt.u, tt.v); got != tt.wantDist {
+				t.Errorf("HLD.Dist() = %d, want %d", got, tt.wantDist)
+			}
+			if got := hldVertices(h, h.PathRanges(tt.u, tt.v, false)); !reflect.DeepEqual(got, tt.wantVertices) {
+				t.Errorf("vertices of HLD.PathRanges(edge=false) = %v, want %v", got, tt.wantVertices)
+			}
+			if got := hldVertices(h, h.PathRanges(tt.u, tt.v, true)); !reflect.DeepEqual(got, tt.wantEdgeLower) {
+				t.Errorf("vertices of HLD.PathRanges(edge=true) = %v, want %v", got, tt.wantEdgeLower)
+			}
+		})
+	}
+}
+
+func TestHLD_SubtreeRange(t *testing.T) {
+	tests := []struct {
+		name         string
+		v            int
+		wantVertices []int
+	}{
+		{name: "根", v: 0, wantVertices: []int{0, 1, 2, 3, 4, 5, 6, 7}},
+		{name: "内部の頂点", v: 1, wantVertices: []int{1, 4, 5, 7}},
+		{name: "葉", v: 7, wantVertices: []int{7}},
+	}
+	h := newTestHLD()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, r := h.SubtreeRange(tt.v, false)
+			if got := hldVertices(h, [][2]int{{l, r}}); !reflect.DeepEqual(got, tt.wantVertices) {
+				t.Errorf("vertices of HLD.SubtreeRange() = %v, want %v", got, tt.wantVertices)
+			}
+			if p := h.Parent[tt.v]; p >= 0 && h.EdgeIndex(p, tt.v) != h.In[tt.v] {
+				t.Errorf("HLD.EdgeIndex(%d, %d) = %d, want %d", p, tt.v, h.EdgeIndex(p, tt.v), h.In[tt.v])
+			}
+		})
+	}
+}
+
+// TestHLD_PathRanges_Random は、ランダムな木について、親を辿る愚直な方法で求めたパスと比較します.
+func TestHLD_PathRanges_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		n := 1 + r.Intn(30)
+		list, parent := randomTree(r, n)
+		h := MustNewHLD(list, 0)
+		for j := 0; j < 10; j++ {
+			u, v := r.Intn(n), r.Intn(n)
+			want, lca := bruteForcePath(parent, u, v)
+			if got := h.LCA(u, v); got != lca {
+				t.Fatalf("HLD.LCA(%d, %d) = %d, want %d", u, v, got, lca)
+			}
+			if got := hldVertices(h, h.PathRanges(u, v, false)); !reflect.DeepEqual(got, want) {
+				t.Fatalf("vertices of HLD.PathRanges(%d, %d, false) = %v, want %v", u, v, got, want)
+			}
+		}
+	}
+}
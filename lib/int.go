@@ -1,7 +1,7 @@
 package lib
 
 import (
-	"math"
+	"fmt"
 )
 
 // PrimeFactors はnを素因数分解します.
@@ -31,9 +31,66 @@ func PrimeFactorsAAA(n int) (pfs []int) {
 	return
 }
 
-// Pow10AAA は、10のy乗を返します.
+// Pow10AAA は、10のy乗を返します. yが負の場合は0を返します.
+// float64を経由しないため、10^18のような大きな値も正確に計算できます.
 func Pow10AAA(y AAA) AAA {
-	return AAA(math.Pow10(int(y)))
+	if y < 0 {
+		return 0
+	}
+	v := AAA(1)
+	for i := AAA(0); i < y; i++ {
+		v *= 10
+	}
+	return v
+}
+
+// CheckedAddAAA は、a+bを返します. 結果がオーバーフローする場合はエラーを返します.
+func CheckedAddAAA(a, b AAA) (AAA, error) {
+	s := a + b
+	if (b > 0 && s < a) || (b < 0 && s > a) {
+		return 0, fmt.Errorf("%v + %v overflows", a, b)
+	}
+	return s, nil
+}
+
+// CheckedMulAAA は、a*bを返します. 結果がオーバーフローする場合はエラーを返します.
+func CheckedMulAAA(a, b AAA) (AAA, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	p := a * b
+	// -1と最小値の積は、オーバーフローしても除算で検出できないため個別に判定する
+	if p/a != b || (a == -1 && b == -b) || (b == -1 && a == -a) {
+		return 0, fmt.Errorf("%v * %v overflows", a, b)
+	}
+	return p, nil
+}
+
+// CheckedPowAAA は、xのn乗を返します. 結果がオーバーフローする場合や、nが負の場合はエラーを返します.
+func CheckedPowAAA(x AAA, n int) (AAA, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("negative n is given: %d", n)
+	}
+	switch {
+	case n == 0 || x == 1:
+		return 1, nil
+	case x == 0:
+		return 0, nil
+	case x == -1 && n%2 == 0:
+		return 1, nil
+	case x == -1:
+		return -1, nil
+	}
+
+	// 絶対値が2以上の値は高々63回のかけ算でオーバーフローするため、ループは十分早く終わる
+	res := AAA(1)
+	for i := 0; i < n; i++ {
+		var err error
+		if res, err = CheckedMulAAA(res, x); err != nil {
+			return 0, fmt.Errorf("%v^%d overflows", x, n)
+		}
+	}
+	return res, nil
 }
 
 // AAASliceToMap は、与えられた値をkeyとして持つmapを返します
@@ -1,6 +1,7 @@
 package lib
 
 import (
+	"math"
 	"reflect"
 	"testing"
 )
@@ -66,3 +67,194 @@ func TestAAASliceToMap(t *testing.T) {
 		})
 	}
 }
+
+func TestPow10Int64(t *testing.T) {
+	tests := []struct {
+		name string
+		y    int64
+		want int64
+	}{
+		{name: "Pow10Int64", y: 0, want: 1},
+		{name: "Pow10Int64", y: 3, want: 1000},
+		{name: "Pow10Int64", y: 18, want: 1000000000000000000},
+		{name: "Pow10Int64", y: -1, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Pow10Int64(tt.y); got != tt.want {
+				t.Errorf("Pow10Int64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckedAddInt64(t *testing.T) {
+	type args struct {
+		a int64
+		b int64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int64
+		wantErr bool
+	}{
+		{
+			name: "CheckedAddInt64",
+			args: args{a: 1, b: 2},
+			want: 3,
+		},
+		{
+			name: "CheckedAddInt64",
+			args: args{a: math.MaxInt64, b: math.MinInt64},
+			want: -1,
+		},
+		{
+			name:    "CheckedAddInt64",
+			args:    args{a: math.MaxInt64, b: 1},
+			wantErr: true,
+		},
+		{
+			name:    "CheckedAddInt64",
+			args:    args{a: math.MinInt64, b: -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckedAddInt64(tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckedAddInt64() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CheckedAddInt64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckedMulInt8(t *testing.T) {
+	// int8の全ての組み合わせについて、intで計算した結果と比較する
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			got, err := CheckedMulInt8(int8(a), int8(b))
+			want := a * b
+			overflow := want < math.MinInt8 || want > math.MaxInt8
+			if (err != nil) != overflow {
+				t.Fatalf("CheckedMulInt8(%d, %d) error = %v, want overflow %v", a, b, err, overflow)
+			}
+			if !overflow && int(got) != want {
+				t.Fatalf("CheckedMulInt8(%d, %d) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestCheckedMulInt64(t *testing.T) {
+	type args struct {
+		a int64
+		b int64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int64
+		wantErr bool
+	}{
+		{
+			// float64を経由すると2^53を超える値が丸められる
+			name: "CheckedMulInt64",
+			args: args{a: 3037000499, b: 3037000499},
+			want: 9223372030926249001,
+		},
+		{
+			name:    "CheckedMulInt64",
+			args:    args{a: 3037000500, b: 3037000500},
+			wantErr: true,
+		},
+		{
+			name: "CheckedMulInt64",
+			args: args{a: math.MinInt64, b: 1},
+			want: math.MinInt64,
+		},
+		{
+			name:    "CheckedMulInt64",
+			args:    args{a: math.MinInt64, b: -1},
+			wantErr: true,
+		},
+		{
+			name:    "CheckedMulInt64",
+			args:    args{a: -1, b: math.MinInt64},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckedMulInt64(tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckedMulInt64() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CheckedMulInt64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckedPowInt64(t *testing.T) {
+	type args struct {
+		x int64
+		n int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int64
+		wantErr bool
+	}{
+		{
+			name: "CheckedPowInt64",
+			args: args{x: 10, n: 18},
+			want: 1000000000000000000,
+		},
+		{
+			name: "CheckedPowInt64",
+			args: args{x: -2, n: 63},
+			want: math.MinInt64,
+		},
+		{
+			name: "CheckedPowInt64",
+			args: args{x: -1, n: 1000000000000000001},
+			want: -1,
+		},
+		{
+			name:    "CheckedPowInt64",
+			args:    args{x: 10, n: 19},
+			wantErr: true,
+		},
+		{
+			name:    "CheckedPowInt64",
+			args:    args{x: 2, n: 63},
+			wantErr: true,
+		},
+		{
+			name:    "CheckedPowInt64",
+			args:    args{x: 2, n: -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckedPowInt64(tt.args.x, tt.args.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckedPowInt64() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CheckedPowInt64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
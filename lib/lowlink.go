@@ -0,0 +1,196 @@
+package lib
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LowLink は、無向グラフに対してlowlinkを求めた結果を保持します.
+// 辺は追加した順に0始まりの番号で識別され、多重辺や自己ループも扱えます.
+type LowLink struct {
+	nodeNum int
+	adj     [][][2]int
+
+	// Ord は、各頂点のDFSでの訪問順です.
+	Ord []int
+	// Low は、各頂点からDFS木の辺を下り、後退辺を高々1本使って到達できる頂点のOrdの最小値です.
+	Low []int
+	// Bridges は、橋となる辺の番号の昇順のsliceです.
+	Bridges []int
+	// ArticulationPoints は、関節点の昇順のsliceです.
+	ArticulationPoints []int
+
+	isBridge []bool
+	blocks   [][]int
+}
+
+// NewLowLink は、nodeNum頂点とedges(各要素は{u, v})からなる無向グラフのlowlinkを求めます.
+// 再帰を使わずにDFSを行うため、2*10^5頂点のパスグラフのような深いグラフでも利用できます. 計算量はO(V+E)です.
+func NewLowLink(nodeNum int, edges [][]int) (*LowLink, error) {
+	if nodeNum < 0 {
+		return nil, fmt.Errorf("invalid nodeNum: %d", nodeNum)
+	}
+	l := &LowLink{
+		nodeNum:  nodeNum,
+		adj:      make([][][2]int, nodeNum),
+		Ord:      NewIntSliceWithInitialValue(nodeNum, -1),
+		Low:      make([]int, nodeNum),
+		isBridge: make([]bool, len(edges)),
+	}
+	for i, e := range edges {
+		if len(e) != 2 {
+			return nil, fmt.Errorf("%dth edge has invalid length: %v", i, e)
+		}
+		u, v := e[0], e[1]
+		if u < 0 || u >= nodeNum || v < 0 || v >= nodeNum {
+			return nil, fmt.Errorf("%dth edge has out of range node: %v", i, e)
+		}
+		l.adj[u] = append(l.adj[u], [2]int{v, i})
+		if u != v {
+			l.adj[v] = append(l.adj[v], [2]int{u, i})
+		}
+	}
+	l.build()
+	return l, nil
+}
+
+func (l *LowLink) build() {
+	parent := NewIntSliceWithInitialValue(l.nodeNum, -1)
+	parentEdge := NewIntSliceWithInitialValue(l.nodeNum, -1)
+	iter := make([]int, l.nodeNum)
+	childNum := make([]int, l.nodeNum)
+	isArticulation := make([]bool, l.nodeNum)
+	var stack, visitedStack []int
+	k := 0
+	for root := 0; root < l.nodeNum; root++ {
+		if l.Ord[root] >= 0 {
+			continue
+		}
+		l.Ord[root], l.Low[root] = k, k
+		k++
+		stack = append(stack, root)
+		visitedStack = append(visitedStack, root)
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			if iter[v] < len(l.adj[v]) {
+				e := l.adj[v][iter[v]]
+				iter[v]++
+				to, id := e[0], e[1]
+				if id == parentEdge[v] {
+					continue
+				}
+				if l.Ord[to] < 0 {
+					parent[to], parentEdge[to] = v, id
+					l.Ord[to], l.Low[to] = k, k
+					k++
+					stack = append(stack, to)
+					visitedStack = append(visitedStack, to)
+				} else if l.Ord[to] < l.Low[v] {
+					l.Low[v] = l.Ord[to]
+				}
+				continue
+			}
+
+			stack = stack[:len(stack)-1]
+			p := parent[v]
+			if p < 0 {
+				continue
+			}
+			childNum[p]++
+			if l.Low[v] < l.Low[p] {
+				l.Low[p] = l.Low[v]
+			}
+			if l.Ord[p] < l.Low[v] {
+				l.isBridge[parentEdge[v]] = true
+			}
+			if l.Ord[p] <= l.Low[v] {
+				if parent[p] >= 0 {
+					isArticulation[p] = true
+				}
+				block := []int{p}
+				for {
+					w := visitedStack[len(visitedStack)-1]
+					visitedStack = visitedStack[:len(visitedStack)-1]
+					block = append(block, w)
+					if w == v {
+						break
+					}
+				}
+				sort.Ints(block)
+				l.blocks = append(l.blocks, block)
+			}
+		}
+		if childNum[root] >= 2 {
+			isArticulation[root] = true
+		}
+		if childNum[root] == 0 {
+			l.blocks = append(l.blocks, []int{root})
+		}
+		visitedStack = visitedStack[:0]
+	}
+
+	for i, ok := range l.isBridge {
+		if ok {
+			l.Bridges = append(l.Bridges, i)
+		}
+	}
+	for v, ok := range isArticulation {
+		if ok {
+			l.ArticulationPoints = append(l.ArticulationPoints, v)
+		}
+	}
+}
+
+// IsBridge は、i番目の辺が橋であるかを返します.
+func (l *LowLink) IsBridge(i int) bool {
+	return l.isBridge[i]
+}
+
+// TwoEdgeConnectedComponents は、橋を取り除いた後の連結成分(二重辺連結成分)を返します.
+// 各成分は頂点の昇順で、成分は最小の頂点の昇順に並びます.
+func (l *LowLink) TwoEdgeConnectedComponents() [][]int {
+	ids := NewIntSliceWithInitialValue(l.nodeNum, -1)
+	var groups [][]int
+	for start := 0; start < l.nodeNum; start++ {
+		if ids[start] >= 0 {
+			continue
+		}
+		id := len(groups)
+		ids[start] = id
+		group := []int{start}
+		for head := 0; head < len(group); head++ {
+			v := group[head]
+			for _, e := range l.adj[v] {
+				if l.isBridge[e[1]] || ids[e[0]] >= 0 {
+					continue
+				}
+				ids[e[0]] = id
+				group = append(group, e[0])
+			}
+		}
+		sort.Ints(group)
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// BiconnectedComponents は、二重頂点連結成分(ブロック)に含まれる頂点を昇順で返します.
+// 関節点は複数の成分に含まれ、孤立点はそれ自身のみからなる成分になります.
+func (l *LowLink) BiconnectedComponents() [][]int {
+	return l.blocks
+}
+
+// BlockCutTree は、元の頂点0...V-1とブロックV...V+B-1を頂点とし、
+// 各頂点とそれを含むブロックを辺で結んだ森(block-cut tree)の隣接リストを返します.
+// ブロックの番号はBiconnectedComponentsの順序と対応します.
+func (l *LowLink) BlockCutTree() [][]int {
+	tree := make([][]int, l.nodeNum+len(l.blocks))
+	for i, block := range l.blocks {
+		b := l.nodeNum + i
+		for _, v := range block {
+			tree[v] = append(tree[v], b)
+			tree[b] = append(tree[b], v)
+		}
+	}
+	return tree
+}
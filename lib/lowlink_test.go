@@ -0,0 +1,224 @@
+package lib
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// countComponents は、removedNodeと、removedEdgeの辺を取り除いたグラフの連結成分の数を返します.
+func countComponents(nodeNum int, edges [][]int, removedNode, removedEdge int) int {
+	u := NewUnionFindInt(MustIntRange(0, nodeNum, 1))
+	for i, e := range edges {
+		if i == removedEdge || e[0] == removedNode || e[1] == removedNode {
+			continue
+		}
+		u.Unite(e[0], e[1])
+	}
+	cnt := 0
+	for v := 0; v < nodeNum; v++ {
+		if root, _ := u.GetRoot(v); root == v && v != removedNode {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+func TestNewLowLink(t *testing.T) {
+	type args struct {
+		nodeNum int
+		edges   [][]int
+	}
+	tests := []struct {
+		name                   string
+		args                   args
+		wantBridges            []int
+		wantArticulationPoints []int
+		wantTwoEdgeCC          [][]int
+		wantBiconnected        [][]int
+		wantErr                bool
+	}{
+		{
+			name: "NewLowLink",
+			args: args{
+				// 0 - 1 - 2 - 0 (triangle), 2 - 3, 3 - 4 - 5 - 3 (triangle), 6
+				nodeNum: 7,
+				edges:   [][]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}},
+			},
+			wantBridges:            []int{3},
+			wantArticulationPoints: []int{2, 3},
+			wantTwoEdgeCC:          [][]int{{0, 1, 2}, {3, 4, 5}, {6}},
+			wantBiconnected:        [][]int{{3, 4, 5}, {2, 3}, {0, 1, 2}, {6}},
+		},
+		{
+			name: "NewLowLink",
+			args: args{
+				// 0 = 1 (multiple edges) - 2
+				nodeNum: 3,
+				edges:   [][]int{{0, 1}, {1, 0}, {1, 2}},
+			},
+			wantBridges:            []int{2},
+			wantArticulationPoints: []int{1},
+			wantTwoEdgeCC:          [][]int{{0, 1}, {2}},
+			wantBiconnected:        [][]int{{1, 2}, {0, 1}},
+		},
+		{
+			name: "NewLowLink",
+			args: args{
+				// 0 - 0 (self loop), 0 - 1
+				nodeNum: 2,
+				edges:   [][]int{{0, 0}, {0, 1}},
+			},
+			wantBridges:            []int{1},
+			wantArticulationPoints: nil,
+			wantTwoEdgeCC:          [][]int{{0}, {1}},
+			wantBiconnected:        [][]int{{0, 1}},
+		},
+		{
+			name: "NewLowLink",
+			args: args{
+				// 0 - 1 - 2 - 3 - 0 (cycle)
+				nodeNum: 4,
+				edges:   [][]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}},
+			},
+			wantBridges:            nil,
+			wantArticulationPoints: nil,
+			wantTwoEdgeCC:          [][]int{{0, 1, 2, 3}},
+			wantBiconnected:        [][]int{{0, 1, 2, 3}},
+		},
+		{
+			name: "NewLowLink",
+			args: args{
+				nodeNum: 2,
+				edges:   [][]int{{0, 2}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLowLink(tt.args.nodeNum, tt.args.edges)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewLowLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(l.Bridges, tt.wantBridges) {
+				t.Errorf("LowLink.Bridges = %v, want %v", l.Bridges, tt.wantBridges)
+			}
+			if !reflect.DeepEqual(l.ArticulationPoints, tt.wantArticulationPoints) {
+				t.Errorf("LowLink.ArticulationPoints = %v, want %v", l.ArticulationPoints, tt.wantArticulationPoints)
+			}
+			if got := l.TwoEdgeConnectedComponents(); !reflect.DeepEqual(got, tt.wantTwoEdgeCC) {
+				t.Errorf("LowLink.TwoEdgeConnectedComponents() = %v, want %v", got, tt.wantTwoEdgeCC)
+			}
+			if got := l.BiconnectedComponents(); !reflect.DeepEqual(got, tt.wantBiconnected) {
+				t.Errorf("LowLink.BiconnectedComponents() = %v, want %v", got, tt.wantBiconnected)
+			}
+		})
+	}
+}
+
+func TestLowLink_BlockCutTree(t *testing.T) {
+	type args struct {
+		nodeNum int
+		edges   [][]int
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]int
+	}{
+		{
+			name: "LowLink_BlockCutTree",
+			args: args{
+				// 0 - 1 - 2 - 0 (triangle), 2 - 3, 3 - 4 - 5 - 3 (triangle), 6
+				nodeNum: 7,
+				edges:   [][]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}},
+			},
+			want: [][]int{{9}, {9}, {8, 9}, {7, 8}, {7}, {7}, {10}, {3, 4, 5}, {2, 3}, {0, 1, 2}, {6}},
+		},
+		{
+			name: "LowLink_BlockCutTree",
+			args: args{
+				nodeNum: 1,
+				edges:   nil,
+			},
+			want: [][]int{{1}, {0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := MustNewLowLink(tt.args.nodeNum, tt.args.edges)
+			if got := l.BlockCutTree(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LowLink.BlockCutTree() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewLowLink_BruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		nodeNum := 1 + r.Intn(7)
+		var edges [][]int
+		for j := r.Intn(10); j > 0; j-- {
+			edges = append(edges, []int{r.Intn(nodeNum), r.Intn(nodeNum)})
+		}
+		l := MustNewLowLink(nodeNum, edges)
+		base := countComponents(nodeNum, edges, -1, -1)
+
+		var wantBridges []int
+		for j := range edges {
+			if countComponents(nodeNum, edges, -1, j) > base {
+				wantBridges = append(wantBridges, j)
+			}
+		}
+		if !reflect.DeepEqual(l.Bridges, wantBridges) {
+			t.Fatalf("LowLink.Bridges = %v, want %v, edges: %v", l.Bridges, wantBridges, edges)
+		}
+
+		var wantArticulationPoints []int
+		for v := 0; v < nodeNum; v++ {
+			if countComponents(nodeNum, edges, v, -1) > base {
+				wantArticulationPoints = append(wantArticulationPoints, v)
+			}
+		}
+		if !reflect.DeepEqual(l.ArticulationPoints, wantArticulationPoints) {
+			t.Fatalf("LowLink.ArticulationPoints = %v, want %v, edges: %v", l.ArticulationPoints, wantArticulationPoints, edges)
+		}
+
+		blockNum := make([]int, nodeNum)
+		for _, block := range l.BiconnectedComponents() {
+			for _, v := range block {
+				blockNum[v]++
+			}
+		}
+		for v := 0; v < nodeNum; v++ {
+			isArticulation := ContainsInt(wantArticulationPoints, v)
+			if isArticulation != (blockNum[v] > 1) {
+				t.Fatalf("vertex %d is contained in %d blocks, edges: %v", v, blockNum[v], edges)
+			}
+		}
+	}
+}
+
+func TestNewLowLink_LongPath(t *testing.T) {
+	n := 200000
+	edges := make([][]int, n-1)
+	for i := range edges {
+		edges[i] = []int{i, i + 1}
+	}
+	l := MustNewLowLink(n, edges)
+	if len(l.Bridges) != n-1 {
+		t.Errorf("len(LowLink.Bridges) = %v, want %v", len(l.Bridges), n-1)
+	}
+	if len(l.ArticulationPoints) != n-2 {
+		t.Errorf("len(LowLink.ArticulationPoints) = %v, want %v", len(l.ArticulationPoints), n-2)
+	}
+	if got := len(l.BlockCutTree()); got != n+n-1 {
+		t.Errorf("len(LowLink.BlockCutTree()) = %v, want %v", got, n+n-1)
+	}
+}
@@ -6,13 +6,28 @@ import (
 )
 
 // PowAAA は、xのy乗を返します.
+// yが0以上の整数の場合はfloat64を経由せずに繰り返し二乗法で計算するため、2^53を超える整数の結果も正確です.
+// オーバーフローは検出しません.
 func PowAAA(x, y AAA) AAA {
+	if y >= 0 && float64(y) < 1<<62 && AAA(int64(y)) == y {
+		res := AAA(1)
+		for n := int64(y); n > 0; n >>= 1 {
+			if n&1 == 1 {
+				res *= x
+			}
+			x *= x
+		}
+		return res
+	}
 	return AAA(math.Pow(float64(x), float64(y)))
 }
 
 // AbsAAA は、与えられた値の絶対値を返します.
 func AbsAAA(value AAA) AAA {
-	return AAA(math.Abs(float64(value)))
+	if value < 0 {
+		return -value
+	}
+	return value
 }
 
 // MaxAAA は、与えられた値の最大値を返します.
@@ -0,0 +1,166 @@
+package lib
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPowAAA(t *testing.T) {
+	type args struct {
+		x AAA
+		y AAA
+	}
+	tests := []struct {
+		name string
+		args args
+		want AAA
+	}{
+		{
+			name: "PowAAA",
+			args: args{x: 2, y: 10},
+			want: 1024,
+		},
+		{
+			name: "PowAAA",
+			args: args{x: 5, y: 0},
+			want: 1,
+		},
+		{
+			name: "PowAAA",
+			args: args{x: -3, y: 3},
+			want: -27,
+		},
+		{
+			// yが整数の場合は繰り返し二乗法で計算する
+			name: "PowAAA",
+			args: args{x: 2, y: 62},
+			want: 4611686018427387904,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PowAAA(tt.args.x, tt.args.y); got != tt.want {
+				t.Errorf("PowAAA() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPowInt64(t *testing.T) {
+	type args struct {
+		x int64
+		y int64
+	}
+	tests := []struct {
+		name string
+		args args
+		want int64
+	}{
+		{
+			name: "PowInt64",
+			args: args{x: 2, y: 10},
+			want: 1024,
+		},
+		{
+			name: "PowInt64",
+			args: args{x: 5, y: 0},
+			want: 1,
+		},
+		{
+			name: "PowInt64",
+			args: args{x: -3, y: 3},
+			want: -27,
+		},
+		{
+			// float64を経由すると2^53を超える値が丸められる
+			name: "PowInt64",
+			args: args{x: 3, y: 39},
+			want: 4052555153018976267,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PowInt64(tt.args.x, tt.args.y); got != tt.want {
+				t.Errorf("PowInt64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPowAAA_NonInteger(t *testing.T) {
+	type args struct {
+		x AAA
+		y AAA
+	}
+	tests := []struct {
+		name string
+		args args
+		want AAA
+	}{
+		{
+			name: "PowAAA",
+			args: args{x: 1.5, y: 2},
+			want: 2.25,
+		},
+		{
+			name: "PowAAA",
+			args: args{x: 4, y: 0.5},
+			want: 2,
+		},
+		{
+			name: "PowAAA",
+			args: args{x: 2, y: -1},
+			want: 0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PowAAA(tt.args.x, tt.args.y); math.Abs(float64(got-tt.want)) > 1e-9 {
+				t.Errorf("PowAAA() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAbsInt64(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int64
+		want  int64
+	}{
+		{name: "AbsInt64", value: -3, want: 3},
+		{name: "AbsInt64", value: 0, want: 0},
+		{
+			// float64を経由すると999999999999999999は1e18に丸められる
+			name:  "AbsInt64",
+			value: -999999999999999999,
+			want:  999999999999999999,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AbsInt64(tt.value); got != tt.want {
+				t.Errorf("AbsInt64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAbsAAA(t *testing.T) {
+	tests := []struct {
+		name  string
+		value AAA
+		want  AAA
+	}{
+		{name: "AbsAAA", value: -3, want: 3},
+		{name: "AbsAAA", value: 0, want: 0},
+		{name: "AbsAAA", value: 2.5, want: 2.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AbsAAA(tt.value); got != tt.want {
+				t.Errorf("AbsAAA() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Matrix は、整数を要素に持つ行列です. m[i][j]がi行j列の要素です.
+type Matrix [][]int
+
+// NewMatrix は、全ての要素が0のh行w列の行列を返します.
+func NewMatrix(h, w int) Matrix {
+	m := make(Matrix, h)
+	for i := range m {
+		m[i] = make([]int, w)
+	}
+	return m
+}
+
+// NewIdentityMatrix は、n次の単位行列を返します.
+func NewIdentityMatrix(n int) Matrix {
+	m := NewMatrix(n, n)
+	for i := range m {
+		m[i][i] = 1
+	}
+	return m
+}
+
+// H は、行数を返します.
+func (m Matrix) H() int {
+	return len(m)
+}
+
+// W は、列数を返します.
+func (m Matrix) W() int {
+	if len(m) == 0 {
+		return 0
+	}
+	return len(m[0])
+}
+
+// Copy は、要素をコピーした新しい行列を返します.
+func (m Matrix) Copy() Matrix {
+	c := make(Matrix, len(m))
+	for i, row := range m {
+		c[i] = append([]int{}, row...)
+	}
+	return c
+}
+
+func (m Matrix) validateMul(o Matrix) error {
+	if m.W() != o.H() {
+		return fmt.Errorf("matrix sizes are mismatched: %dx%d * %dx%d", m.H(), m.W(), o.H(), o.W())
+	}
+	return nil
+}
+
+// Mul は、行列の積m*oを返します. オーバーフローは考慮しません.
+func (m Matrix) Mul(o Matrix) (Matrix, error) {
+	if err := m.validateMul(o); err != nil {
+		return nil, err
+	}
+	res := NewMatrix(m.H(), o.W())
+	for i, row := range m {
+		for k, v := range row {
+			if v == 0 {
+				continue
+			}
+			for j, w := range o[k] {
+				res[i][j] += v * w
+			}
+		}
+	}
+	return res, nil
+}
+
+// MulMod は、行列の積m*oの各要素をmodで割ったあまりを返します. 各要素は0以上mod未満である必要があります.
+// (mod-1)^2がintの範囲に収まる必要があります.
+func (m Matrix) MulMod(o Matrix, mod int) (Matrix, error) {
+	if err := m.validateMul(o); err != nil {
+		return nil, err
+	}
+	res := NewMatrix(m.H(), o.W())
+	for i, row := range m {
+		for k, v := range row {
+			if v == 0 {
+				continue
+			}
+			for j, w := range o[k] {
+				res[i][j] = (res[i][j] + v*w) % mod
+			}
+		}
+	}
+	return res, nil
+}
+
+// maxMatrixMod は、PowModで利用できるmodの最大値です. (mod-1)*modがintの範囲に収まる最大の値です.
+const maxMatrixMod = 3037000500
+
+// PowMod は、正方行列mのn乗の各要素をmodで割ったあまりを返します. 計算量はmをN次としてO(N^3 log n)です.
+// 線形漸化式の第n項を求める場合などに利用できます. modは1以上maxMatrixMod以下である必要があります.
+func (m Matrix) PowMod(n, mod int) (Matrix, error) {
+	if mod < 1 || mod > maxMatrixMod {
+		return nil, fmt.Errorf("mod must be in [1, %d]: %d", maxMatrixMod, mod)
+	}
+	if m.H() != m.W() {
+		return nil, fmt.Errorf("matrix is not square: %dx%d", m.H(), m.W())
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("negative n is given: %d", n)
+	}
+	res, a := NewIdentityMatrix(m.H()), m.modCopy(mod)
+	for i := range res {
+		res[i][i] %= mod
+	}
+	for n > 0 {
+		if n&1 == 1 {
+			res, _ = res.MulMod(a, mod)
+		}
+		a, _ = a.MulMod(a, mod)
+		n >>= 1
+	}
+	return res, nil
+}
+
+// FibonacciMod は、F(0) = 0, F(1) = 1であるフィボナッチ数列の第n項をmodで割ったあまりを返します. 計算量はO(log n)です.
+func FibonacciMod(n, mod int) (int, error) {
+	p, err := Matrix{{1, 1}, {1, 0}}.PowMod(n, mod)
+	if err != nil {
+		return 0, err
+	}
+	return p[0][1], nil
+}
+
+// modCopy は、各要素を[0, mod)に正規化した行列を返します.
+func (m Matrix) modCopy(mod int) Matrix {
+	c := make(Matrix, len(m))
+	for i, row := range m {
+		c[i] = normalizeMod(row, mod)
+	}
+	return c
+}
+
+// gaussJordanMod は、素数modの下で先頭cols列についてmを掃き出した行列と、その階数を返します.
+// detは先頭cols列が正方行列をなす場合の行列式です. mは変更されません.
+func (m Matrix) gaussJordanMod(cols, mod int) (a Matrix, rank, det int) {
+	a, det = m.modCopy(mod), 1%mod
+	for c := 0; c < cols && rank < len(a); c++ {
+		pivot := -1
+		for r := rank; r < len(a); r++ {
+			if a[r][c] != 0 {
+				pivot = r
+				break
+			}
+		}
+		if pivot < 0 {
+			det = 0
+			continue
+		}
+		if pivot != rank {
+			a[pivot], a[rank] = a[rank], a[pivot]
+			det = (mod - det) % mod
+		}
+		det = det * a[rank][c] % mod
+		inv := ModPow(a[rank][c], mod-2, mod)
+		for j := range a[rank] {
+			a[rank][j] = a[rank][j] * inv % mod
+		}
+		for r := range a {
+			if r == rank || a[r][c] == 0 {
+				continue
+			}
+			f := a[r][c]
+			for j := range a[r] {
+				a[r][j] = (a[r][j] - f*a[rank][j]%mod + mod) % mod
+			}
+		}
+		rank++
+	}
+	if rank < cols {
+		det = 0
+	}
+	return
+}
+
+// RankMod は、素数modの下での行列の階数を返します. 計算量はO(HW min(H, W))です.
+func (m Matrix) RankMod(mod int) int {
+	_, rank, _ := m.gaussJordanMod(m.W(), mod)
+	return rank
+}
+
+// DetMod は、素数modの下での正方行列の行列式を返します. 計算量はO(N^3)です.
+func (m Matrix) DetMod(mod int) (int, error) {
+	if m.H() != m.W() {
+		return 0, fmt.Errorf("matrix is not square: %dx%d", m.H(), m.W())
+	}
+	_, _, det := m.gaussJordanMod(m.W(), mod)
+	return det, nil
+}
+
+// InverseMod は、素数modの下での正方行列の逆行列を返します. 正則でない場合はエラーを返します.
+func (m Matrix) InverseMod(mod int) (Matrix, error) {
+	n := m.H()
+	if n != m.W() {
+		return nil, fmt.Errorf("matrix is not square: %dx%d", m.H(), m.W())
+	}
+	aug := NewMatrix(n, 2*n)
+	for i := range aug {
+		copy(aug[i], m[i])
+		aug[i][n+i] = 1
+	}
+	a, rank, _ := aug.gaussJordanMod(n, mod)
+	if rank < n {
+		return nil, errors.New("matrix is singular")
+	}
+	inv := make(Matrix, n)
+	for i := range inv {
+		inv[i] = a[i][n:]
+	}
+	return inv, nil
+}
+
+// SolveLinearMod は、素数modの下で連立一次方程式ax = bを解き、解の1つxと、ax = 0の解空間の基底を返します.
+// 解全体はxに基底の線形結合を加えたものになります. 解が存在しない場合はエラーを返します. 計算量はO(HW min(H, W))です.
+func SolveLinearMod(a Matrix, b []int, mod int) (x []int, basis [][]int, err error) {
+	h, w := a.H(), a.W()
+	if len(b) != h {
+		return nil, nil, fmt.Errorf("a and b lengths are different. a:%d b:%d", h, len(b))
+	}
+	aug := NewMatrix(h, w+1)
+	for i := range aug {
+		copy(aug[i], a[i])
+		aug[i][w] = b[i]
+	}
+	e, rank, _ := aug.gaussJordanMod(w, mod)
+	for r := rank; r < h; r++ {
+		if e[r][w] != 0 {
+			return nil, nil, errors.New("no solution")
+		}
+	}
+
+	x = make([]int, w)
+	pivots := make([]int, rank)
+	isPivot := make([]bool, w)
+	for r, c := 0, 0; r < rank; r++ {
+		for e[r][c] == 0 {
+			c++
+		}
+		pivots[r], isPivot[c] = c, true
+		x[c] = e[r][w]
+	}
+	for c := 0; c < w; c++ {
+		if isPivot[c] {
+			continue
+		}
+		v := make([]int, w)
+		v[c] = 1 % mod
+		for r, p := range pivots {
+			v[p] = (mod - e[r][c]) % mod
+		}
+		basis = append(basis, v)
+	}
+	return x, basis, nil
+}
+
+// gf2Rows は、aの各行をbitsetに変換します. extraに値を渡した場合は、各行のw列目にextra[i]を加えます.
+func gf2Rows(a [][]bool, w int, extra []bool) [][]uint64 {
+	words := (w + 1 + 63) / 64
+	rows := make([][]uint64, len(a))
+	for i, line := range a {
+		rows[i] = make([]uint64, words)
+		for j, v := range line {
+			if v {
+				rows[i][j/64] |= 1 << uint(j%64)
+			}
+		}
+		if extra != nil && extra[i] {
+			rows[i][w/64] |= 1 << uint(w%64)
+		}
+	}
+	return rows
+}
+
+// gaussJordanGF2 は、GF(2)上で先頭cols列についてrowsを掃き出し、階数と各行の主成分の列を返します. rowsは変更されます.
+func gaussJordanGF2(rows [][]uint64, cols int) (rank int, pivots []int) {
+	for c := 0; c < cols && rank < len(rows); c++ {
+		word, bit := c/64, uint64(1)<<uint(c%64)
+		pivot := -1
+		for r := rank; r < len(rows); r++ {
+			if rows[r][word]&bit != 0 {
+				pivot = r
+				break
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+		rows[pivot], rows[rank] = rows[rank], rows[pivot]
+		for r := range rows {
+			if r == rank || rows[r][word]&bit == 0 {
+				continue
+			}
+			for k := range rows[r] {
+				rows[r][k] ^= rows[rank][k]
+			}
+		}
+		pivots = append(pivots, c)
+		rank++
+	}
+	return
+}
+
+// RankGF2 は、GF(2)上での行列の階数を返します. 各行をbitsetとして扱うため、計算量はO(HW min(H, W)/64)です.
+func RankGF2(a [][]bool) int {
+	if len(a) == 0 {
+		return 0
+	}
+	w := len(a[0])
+	rank, _ := gaussJordanGF2(gf2Rows(a, w, nil), w)
+	return rank
+}
+
+// SolveLinearGF2 は、GF(2)上で連立一次方程式ax = b(和はxor)を解き、解の1つを返します. 解が存在しない場合はエラーを返します.
+// 各行をbitsetとして扱うため、計算量はO(HW min(H, W)/64)です.
+func SolveLinearGF2(a [][]bool, b []bool) ([]bool, error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("a and b lengths are different. a:%d b:%d", len(a), len(b))
+	}
+	w := 0
+	if len(a) > 0 {
+		w = len(a[0])
+	}
+	rows := gf2Rows(a, w, b)
+	rank, pivots := gaussJordanGF2(rows, w)
+	for r := rank; r < len(rows); r++ {
+		if rows[r][w/64]>>uint(w%64)&1 == 1 {
+			return nil, errors.New("no solution")
+		}
+	}
+	x := make([]bool, w)
+	for r, c := range pivots {
+		x[c] = rows[r][w/64]>>uint(w%64)&1 == 1
+	}
+	return x, nil
+}
+
+// SolveLinearFloat64 は、N次正方行列aについて連立一次方程式ax = bを部分ピボット選択付きのガウスの消去法で解きます.
+// ピボットの絶対値がeps以下になった場合は、正則でないとみなしてエラーを返します. 計算量はO(N^3)です.
+func SolveLinearFloat64(a [][]float64, b []float64, eps float64) ([]float64, error) {
+	n := len(a)
+	if len(b) != n {
+		return nil, fmt.Errorf("a and b lengths are different. a:%d b:%d", n, len(b))
+	}
+	aug := make([][]float64, n)
+	for i := range aug {
+		if len(a[i]) != n {
+			return nil, fmt.Errorf("matrix is not square: %dth row length is %d", i, len(a[i]))
+		}
+		aug[i] = append(append(make([]float64, 0, n+1), a[i]...), b[i])
+	}
+
+	for c := 0; c < n; c++ {
+		pivot := c
+		for r := c + 1; r < n; r++ {
+			if math.Abs(aug[r][c]) > math.Abs(aug[pivot][c]) {
+				pivot = r
+			}
+		}
+		if math.Abs(aug[pivot][c]) <= eps {
+			return nil, errors.New("matrix is singular")
+		}
+		aug[pivot], aug[c] = aug[c], aug[pivot]
+		for r := c + 1; r < n; r++ {
+			f := aug[r][c] / aug[c][c]
+			for j := c; j <= n; j++ {
+				aug[r][j] -= f * aug[c][j]
+			}
+		}
+	}
+
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		s := aug[i][n]
+		for j := i + 1; j < n; j++ {
+			s -= aug[i][j] * x[j]
+		}
+		x[i] = s / aug[i][i]
+	}
+	return x, nil
+}
@@ -0,0 +1,396 @@
+package lib
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestMatrix_Mul(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Matrix
+		o       Matrix
+		want    Matrix
+		wantErr bool
+	}{
+		{
+			name: "Matrix.Mul",
+			m:    Matrix{{1, 2}, {3, 4}},
+			o:    Matrix{{5, 6}, {7, 8}},
+			want: Matrix{{19, 22}, {43, 50}},
+		},
+		{
+			name: "Matrix.Mul",
+			m:    Matrix{{1, 2, 3}},
+			o:    Matrix{{1}, {2}, {3}},
+			want: Matrix{{14}},
+		},
+		{
+			name:    "Matrix.Mul",
+			m:       Matrix{{1, 2}},
+			o:       Matrix{{1, 2}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Mul(tt.o)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Matrix.Mul() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Matrix.Mul() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatrix_PowMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Matrix
+		n       int
+		mod     int
+		want    Matrix
+		wantErr bool
+	}{
+		{
+			name: "Matrix.PowMod",
+			m:    Matrix{{1, 1}, {1, 0}},
+			n:    10,
+			mod:  1000,
+			want: Matrix{{89, 55}, {55, 34}},
+		},
+		{
+			name: "Matrix.PowMod",
+			m:    Matrix{{2, 0}, {0, 3}},
+			n:    0,
+			mod:  7,
+			want: Matrix{{1, 0}, {0, 1}},
+		},
+		{
+			name:    "Matrix.PowMod",
+			m:       Matrix{{1, 2}},
+			n:       2,
+			mod:     7,
+			wantErr: true,
+		},
+		{
+			name:    "Matrix.PowMod",
+			m:       Matrix{{1, 1}, {1, 0}},
+			n:       2,
+			mod:     0,
+			wantErr: true,
+		},
+		{
+			name: "Matrix.PowMod",
+			m:    Matrix{{maxMatrixMod - 1, 0}, {0, 1}},
+			n:    3,
+			mod:  maxMatrixMod,
+			want: Matrix{{maxMatrixMod - 1, 0}, {0, 1}},
+		},
+		{
+			name:    "Matrix.PowMod",
+			m:       Matrix{{1, 1}, {1, 0}},
+			n:       2,
+			mod:     maxMatrixMod + 1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.PowMod(tt.n, tt.mod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Matrix.PowMod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Matrix.PowMod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFibonacciMod(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		mod  int
+		want int
+	}{
+		{name: "FibonacciMod", n: 0, mod: 1000000007, want: 0},
+		{name: "FibonacciMod", n: 10, mod: 1000000007, want: 55},
+		{name: "FibonacciMod", n: 90, mod: 1000000007, want: 210345902},
+		{name: "FibonacciMod", n: 100000, mod: 998244353, want: 10519474},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MustFibonacciMod(tt.n, tt.mod); got != tt.want {
+				t.Errorf("FibonacciMod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := FibonacciMod(10, 0); err == nil {
+		t.Errorf("FibonacciMod() with mod 0 error = nil, want error")
+	}
+}
+
+func TestMatrix_DetMod(t *testing.T) {
+	const mod = 998244353
+	tests := []struct {
+		name string
+		m    Matrix
+		want int
+	}{
+		{name: "Matrix.DetMod", m: Matrix{{1, 2}, {3, 4}}, want: mod - 2},
+		{name: "Matrix.DetMod", m: Matrix{{0, 1}, {1, 0}}, want: mod - 1},
+		{name: "Matrix.DetMod", m: Matrix{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, want: 0},
+		{name: "Matrix.DetMod", m: Matrix{{2, 0, 1}, {1, 3, 2}, {1, 1, 2}}, want: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.MustDetMod(mod); got != tt.want {
+				t.Errorf("Matrix.DetMod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatrix_InverseMod(t *testing.T) {
+	const mod = 998244353
+	tests := []struct {
+		name    string
+		m       Matrix
+		wantErr bool
+	}{
+		{name: "Matrix.InverseMod", m: Matrix{{5}}},
+		{name: "Matrix.InverseMod", m: Matrix{{1, 2}, {3, 4}}},
+		{
+			// ピボットの選択で行の入れ替えが必要
+			name: "Matrix.InverseMod",
+			m:    Matrix{{0, 1}, {1, 0}},
+		},
+		{name: "Matrix.InverseMod", m: Matrix{{2, 0, 1}, {1, 3, 2}, {1, 1, 2}}},
+		{name: "Matrix.InverseMod", m: Matrix{{1, 2}, {2, 4}}, wantErr: true},
+		{name: "Matrix.InverseMod", m: Matrix{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv, err := tt.m.InverseMod(mod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Matrix.InverseMod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := tt.m.MustMulMod(inv, mod); !reflect.DeepEqual(got, NewIdentityMatrix(tt.m.H())) {
+				t.Errorf("m * Matrix.InverseMod() = %v", got)
+			}
+		})
+	}
+}
+
+func TestSolveLinearMod(t *testing.T) {
+	const mod = 7
+	tests := []struct {
+		name    string
+		a       Matrix
+		b       []int
+		wantDim int
+		wantErr bool
+	}{
+		{
+			// x+y = 3, x-y = 1
+			name:    "SolveLinearMod",
+			a:       Matrix{{1, 1}, {1, 6}},
+			b:       []int{3, 1},
+			wantDim: 0,
+		},
+		{
+			name:    "SolveLinearMod",
+			a:       Matrix{{1, 2, 3}},
+			b:       []int{4},
+			wantDim: 2,
+		},
+		{
+			// 2行目は1行目の2倍
+			name:    "SolveLinearMod",
+			a:       Matrix{{1, 1}, {2, 2}},
+			b:       []int{1, 2},
+			wantDim: 1,
+		},
+		{
+			name:    "SolveLinearMod",
+			a:       Matrix{{1, 1}, {2, 2}},
+			b:       []int{1, 3},
+			wantErr: true,
+		},
+		{
+			name:    "SolveLinearMod",
+			a:       Matrix{{1, 0}, {0, 1}, {1, 1}},
+			b:       []int{2, 3, 5},
+			wantDim: 0,
+		},
+		{
+			name:    "SolveLinearMod",
+			a:       Matrix{{1, 0}, {0, 1}, {1, 1}},
+			b:       []int{2, 3, 6},
+			wantErr: true,
+		},
+		{
+			name:    "SolveLinearMod",
+			a:       Matrix{{0, 0}},
+			b:       []int{0},
+			wantDim: 2,
+		},
+		{
+			name:    "SolveLinearMod",
+			a:       Matrix{{0, 0}},
+			b:       []int{1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, basis, err := SolveLinearMod(tt.a, tt.b, mod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SolveLinearMod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(basis) != tt.wantDim {
+				t.Errorf("len(basis) = %d, want %d", len(basis), tt.wantDim)
+			}
+			// xはax = bの解で、基底の各ベクトルはax = 0の解になる
+			vectors := append([][]int{x}, basis...)
+			for vi, v := range vectors {
+				for j := range tt.a {
+					s := 0
+					for k := range v {
+						s += tt.a[j][k] * v[k]
+					}
+					want := 0
+					if vi == 0 {
+						want = tt.b[j]
+					}
+					if s%mod != want {
+						t.Errorf("SolveLinearMod() = %v, %v is not a solution", x, basis)
+					}
+				}
+			}
+			if got, want := tt.a.RankMod(mod), tt.a.W()-tt.wantDim; got != want {
+				t.Errorf("Matrix.RankMod() = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestSolveLinearGF2(t *testing.T) {
+	// 列数が64を超え、bitsetが複数のwordにまたがる場合も含めて確かめる
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		h, w := 1+r.Intn(6), 1+r.Intn(70)
+		a := make([][]bool, h)
+		b := make([]bool, h)
+		for j := range a {
+			a[j] = make([]bool, w)
+			for k := range a[j] {
+				a[j][k] = r.Intn(4) == 0
+			}
+			b[j] = r.Intn(2) == 0
+		}
+
+		// 方程式の行の組み合わせを総当たりし、係数が全て0になるのに右辺が1になる組み合わせがあれば解なし
+		hasSolution := true
+		for bit := 1; bit < 1<<uint(h); bit++ {
+			coef, rhs := make([]bool, w), false
+			for j := 0; j < h; j++ {
+				if bit>>uint(j)&1 == 0 {
+					continue
+				}
+				for k := range coef {
+					coef[k] = coef[k] != a[j][k]
+				}
+				rhs = rhs != b[j]
+			}
+			if rhs && !ContainsBool(coef, true) {
+				hasSolution = false
+			}
+		}
+
+		x, err := SolveLinearGF2(a, b)
+		if !hasSolution {
+			if err == nil {
+				t.Fatalf("SolveLinearGF2() error = nil, want error")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("SolveLinearGF2() error = %v", err)
+		}
+		for j := 0; j < h; j++ {
+			s := false
+			for k := 0; k < w; k++ {
+				s = s != (a[j][k] && x[k])
+			}
+			if s != b[j] {
+				t.Fatalf("SolveLinearGF2() = %v is not a solution", x)
+			}
+		}
+	}
+
+	if got := RankGF2([][]bool{{true, true, false}, {false, true, true}, {true, false, true}}); got != 2 {
+		t.Errorf("RankGF2() = %v, want %v", got, 2)
+	}
+}
+
+func TestSolveLinearFloat64(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       [][]float64
+		b       []float64
+		want    []float64
+		wantErr bool
+	}{
+		{
+			name: "SolveLinearFloat64",
+			a:    [][]float64{{2, 1}, {1, 3}},
+			b:    []float64{3, 5},
+			want: []float64{0.8, 1.4},
+		},
+		{
+			// ピボット選択をしないと0除算になる
+			name: "SolveLinearFloat64",
+			a:    [][]float64{{0, 1, 1}, {1, 0, 1}, {1, 1, 0}},
+			b:    []float64{5, 4, 3},
+			want: []float64{1, 2, 3},
+		},
+		{
+			name:    "SolveLinearFloat64",
+			a:       [][]float64{{1, 2}, {2, 4}},
+			b:       []float64{1, 2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SolveLinearFloat64(tt.a, tt.b, 1e-9)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SolveLinearFloat64() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			for i := range tt.want {
+				if math.Abs(got[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("SolveLinearFloat64() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
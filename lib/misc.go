@@ -1,7 +1,5 @@
 package lib
 
-import "math"
-
 // GetEachDigitSumAAA は、格桁の値の挿話を返します.
 // ex) 123 -> 1+2+3=6
 func GetEachDigitSumAAA(n AAA) (sum AAA) {
@@ -29,8 +27,8 @@ func ToDigitSliceAAA(n AAA) (digits []int8) {
 // []int8{1, 2, 3} -> 123
 func DigitsToAAA(digits []int8) AAA {
 	v := AAA(0)
-	for i, digit := range digits {
-		v += AAA(float64(digit) * math.Pow(10, float64(len(digits)-i-1)))
+	for _, digit := range digits {
+		v = v*10 + AAA(digit)
 	}
 	return v
 }
@@ -87,6 +87,27 @@ func TestDigitsToValue(t *testing.T) {
 			},
 			want: AAA(123),
 		},
+		{
+			name: "DigitsToAAA",
+			args: args{
+				digits: []int8{},
+			},
+			want: AAA(0),
+		},
+		{
+			name: "DigitsToAAA",
+			args: args{
+				digits: []int8{0, 4, 2},
+			},
+			want: AAA(42),
+		},
+		{
+			name: "DigitsToAAA",
+			args: args{
+				digits: []int8{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
+			},
+			want: AAA(999999999999999),
+		},
 	}
 
 	for _, tt := range tests {
@@ -97,3 +118,30 @@ func TestDigitsToValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDigitsToInt64(t *testing.T) {
+	tests := []struct {
+		name   string
+		digits []int8
+		want   int64
+	}{
+		{
+			name:   "DigitsToInt64",
+			digits: []int8{},
+			want:   0,
+		},
+		{
+			// float64を経由すると下位の桁が失われる
+			name:   "DigitsToInt64",
+			digits: []int8{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
+			want:   999999999999999999,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DigitsToInt64(tt.digits); got != tt.want {
+				t.Errorf("DigitsToInt64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
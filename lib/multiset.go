@@ -0,0 +1,196 @@
+package lib
+
+import "sort"
+
+// sortedMultisetAAABucketSize は、SortedMultisetAAAのbucketの長さの目安です. bucketの長さがこの2倍を超えたら分割します.
+const sortedMultisetAAABucketSize = 256
+
+// SortedMultisetAAA は、値を昇順に保持する多重集合です. 値をソート済みの短いbucketに分割して保持します.
+// bucketの長さをB、bucketの数をN/Bとして、LowerBound, UpperBoundはO(log N)、Insert, EraseはO(N/B + B)、
+// Rank, KthはO(N/B)で動作します. 要素数と操作回数が2*10^5程度であれば、AtCoderの実行時間制限に十分収まります.
+type SortedMultisetAAA struct {
+	buckets [][]AAA
+	size    int
+}
+
+// NewSortedMultisetAAA は、valuesを要素として持つSortedMultisetAAAを返します. 計算量はO(N log N)です.
+func NewSortedMultisetAAA(values ...AAA) *SortedMultisetAAA {
+	sorted := append([]AAA{}, values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	s := &SortedMultisetAAA{size: len(sorted)}
+	for i := 0; i < len(sorted); i += sortedMultisetAAABucketSize {
+		end := i + sortedMultisetAAABucketSize
+		if end > len(sorted) {
+			end = len(sorted)
+		}
+		s.buckets = append(s.buckets, append([]AAA{}, sorted[i:end]...))
+	}
+	return s
+}
+
+// Len は、要素数を返します.
+func (s *SortedMultisetAAA) Len() int {
+	return s.size
+}
+
+// findBucket は、vを挿入すべきbucketのindexを返します. bucketが1つもない場合は0を返します.
+func (s *SortedMultisetAAA) findBucket(v AAA) int {
+	i := sort.Search(len(s.buckets), func(i int) bool {
+		b := s.buckets[i]
+		return b[len(b)-1] >= v
+	})
+	if i == len(s.buckets) && i > 0 {
+		i--
+	}
+	return i
+}
+
+// Insert は、vを1つ追加します.
+func (s *SortedMultisetAAA) Insert(v AAA) {
+	s.size++
+	if len(s.buckets) == 0 {
+		s.buckets = [][]AAA{{v}}
+		return
+	}
+	i := s.findBucket(v)
+	b := s.buckets[i]
+	j := UpperBoundAAA(b, v)
+	b = append(b, 0)
+	copy(b[j+1:], b[j:])
+	b[j] = v
+	s.buckets[i] = b
+
+	if len(b) > 2*sortedMultisetAAABucketSize {
+		half := len(b) / 2
+		right := append([]AAA{}, b[half:]...)
+		s.buckets[i] = b[:half:half]
+		s.buckets = append(s.buckets, nil)
+		copy(s.buckets[i+2:], s.buckets[i+1:])
+		s.buckets[i+1] = right
+	}
+}
+
+// Erase は、vを1つ削除してtrueを返します. vが含まれていない場合は何もせずにfalseを返します.
+func (s *SortedMultisetAAA) Erase(v AAA) bool {
+	if s.size == 0 {
+		return false
+	}
+	i := s.findBucket(v)
+	b := s.buckets[i]
+	j := LowerBoundAAA(b, v)
+	if j == len(b) || b[j] != v {
+		return false
+	}
+	s.size--
+	if len(b) == 1 {
+		s.buckets = append(s.buckets[:i], s.buckets[i+1:]...)
+		return true
+	}
+	s.buckets[i] = append(b[:j], b[j+1:]...)
+	return true
+}
+
+// Contains は、vが含まれているかを返します.
+func (s *SortedMultisetAAA) Contains(v AAA) bool {
+	lb, ok := s.LowerBound(v)
+	return ok && lb == v
+}
+
+// Count は、vの個数を返します.
+func (s *SortedMultisetAAA) Count(v AAA) int {
+	return s.rank(v, true) - s.rank(v, false)
+}
+
+// LowerBound は、v以上の最小の要素を返します. 存在しない場合はokがfalseになります.
+func (s *SortedMultisetAAA) LowerBound(v AAA) (lb AAA, ok bool) {
+	i := sort.Search(len(s.buckets), func(i int) bool {
+		b := s.buckets[i]
+		return b[len(b)-1] >= v
+	})
+	if i == len(s.buckets) {
+		return 0, false
+	}
+	b := s.buckets[i]
+	return b[LowerBoundAAA(b, v)], true
+}
+
+// UpperBound は、vより大きい最小の要素を返します. 存在しない場合はokがfalseになります.
+func (s *SortedMultisetAAA) UpperBound(v AAA) (ub AAA, ok bool) {
+	i := sort.Search(len(s.buckets), func(i int) bool {
+		b := s.buckets[i]
+		return b[len(b)-1] > v
+	})
+	if i == len(s.buckets) {
+		return 0, false
+	}
+	b := s.buckets[i]
+	return b[UpperBoundAAA(b, v)], true
+}
+
+// rank は、inclusiveがfalseの場合はvより小さい要素の数を、trueの場合はv以下の要素の数を返します.
+func (s *SortedMultisetAAA) rank(v AAA, inclusive bool) int {
+	cnt := 0
+	for _, b := range s.buckets {
+		last := b[len(b)-1]
+		if last < v || (inclusive && last == v) {
+			cnt += len(b)
+			continue
+		}
+		if inclusive {
+			return cnt + UpperBoundAAA(b, v)
+		}
+		return cnt + LowerBoundAAA(b, v)
+	}
+	return cnt
+}
+
+// Rank は、vより小さい要素の数を返します. vが含まれている場合は、vが昇順で何番目(0始まり)の要素かと一致します.
+func (s *SortedMultisetAAA) Rank(v AAA) int {
+	return s.rank(v, false)
+}
+
+// Kth は、昇順でk番目(0始まり)の要素を返します. kが範囲外の場合はpanicします.
+func (s *SortedMultisetAAA) Kth(k int) AAA {
+	if k < 0 || k >= s.size {
+		panic("index out of range")
+	}
+	for _, b := range s.buckets {
+		if k < len(b) {
+			return b[k]
+		}
+		k -= len(b)
+	}
+	panic("unreachable")
+}
+
+// Min は、最小の要素を返します. 要素が1つもない場合はpanicします.
+func (s *SortedMultisetAAA) Min() AAA {
+	return s.buckets[0][0]
+}
+
+// Max は、最大の要素を返します. 要素が1つもない場合はpanicします.
+func (s *SortedMultisetAAA) Max() AAA {
+	b := s.buckets[len(s.buckets)-1]
+	return b[len(b)-1]
+}
+
+// Each は、全ての要素を昇順にfに渡します. fがfalseを返した時点で終了します.
+// f内で要素の追加や削除を行ってはいけません.
+func (s *SortedMultisetAAA) Each(f func(v AAA) bool) {
+	for _, b := range s.buckets {
+		for _, v := range b {
+			if !f(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values は、全ての要素を昇順に並べたsliceを返します.
+func (s *SortedMultisetAAA) Values() []AAA {
+	values := make([]AAA, 0, s.size)
+	for _, b := range s.buckets {
+		values = append(values, b...)
+	}
+	return values
+}
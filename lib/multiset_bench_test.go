@@ -0,0 +1,41 @@
+package lib
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func BenchmarkSortedMultiset(b *testing.B) {
+	cases := []struct {
+		opNum int
+	}{
+		{pow10(4)},
+		{2 * pow10(5)},
+	}
+
+	for _, c := range cases {
+		b.Run(fmt.Sprintf("op: %d", c.opNum), func(b *testing.B) {
+			benchmarkSortedMultiset(b, c.opNum)
+		})
+	}
+}
+
+func benchmarkSortedMultiset(b *testing.B, opNum int) {
+	r := rand.New(rand.NewSource(1))
+	values := randomIntSlice(r, opNum, 0, 1000000000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := NewSortedMultisetInt()
+		for _, v := range values {
+			s.Insert(v)
+		}
+		for _, v := range values {
+			s.Kth(s.Rank(v))
+			s.LowerBound(v)
+		}
+		for _, v := range values {
+			s.Erase(v)
+		}
+	}
+}
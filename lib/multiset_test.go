@@ -0,0 +1,189 @@
+package lib
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortedMultisetAAA(t *testing.T) {
+	// 昇順に並べると1, 1, 3, 4, 5, 9
+	s := NewSortedMultisetAAA(5, 1, 3, 1, 4, 9)
+	tests := []struct {
+		name        string
+		v           AAA
+		wantRank    int
+		wantCount   int
+		wantLower   AAA
+		wantLowerOk bool
+		wantUpper   AAA
+		wantUpperOk bool
+	}{
+		{name: "最小値より小さい", v: 0, wantRank: 0, wantCount: 0, wantLower: 1, wantLowerOk: true, wantUpper: 1, wantUpperOk: true},
+		{name: "重複する値", v: 1, wantRank: 0, wantCount: 2, wantLower: 1, wantLowerOk: true, wantUpper: 3, wantUpperOk: true},
+		{name: "含まれない値", v: 2, wantRank: 2, wantCount: 0, wantLower: 3, wantLowerOk: true, wantUpper: 3, wantUpperOk: true},
+		{name: "含まれる値", v: 5, wantRank: 4, wantCount: 1, wantLower: 5, wantLowerOk: true, wantUpper: 9, wantUpperOk: true},
+		{name: "最大値", v: 9, wantRank: 5, wantCount: 1, wantLower: 9, wantLowerOk: true, wantUpperOk: false},
+		{name: "最大値より大きい", v: 10, wantRank: 6, wantCount: 0, wantLowerOk: false, wantUpperOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Rank(tt.v); got != tt.wantRank {
+				t.Errorf("Rank(%v) = %d, want %d", tt.v, got, tt.wantRank)
+			}
+			if got := s.Count(tt.v); got != tt.wantCount {
+				t.Errorf("Count(%v) = %d, want %d", tt.v, got, tt.wantCount)
+			}
+			if got := s.Contains(tt.v); got != (tt.wantCount > 0) {
+				t.Errorf("Contains(%v) = %v, want %v", tt.v, got, tt.wantCount > 0)
+			}
+			if got, ok := s.LowerBound(tt.v); ok != tt.wantLowerOk || (ok && got != tt.wantLower) {
+				t.Errorf("LowerBound(%v) = (%v, %v), want (%v, %v)", tt.v, got, ok, tt.wantLower, tt.wantLowerOk)
+			}
+			if got, ok := s.UpperBound(tt.v); ok != tt.wantUpperOk || (ok && got != tt.wantUpper) {
+				t.Errorf("UpperBound(%v) = (%v, %v), want (%v, %v)", tt.v, got, ok, tt.wantUpper, tt.wantUpperOk)
+			}
+		})
+	}
+
+	if got, want := []AAA{s.Kth(0), s.Kth(2), s.Kth(5)}, []AAA{1, 3, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Kth() = %v, want %v", got, want)
+	}
+	if s.Min() != 1 || s.Max() != 9 {
+		t.Errorf("(Min(), Max()) = (%v, %v), want (1, 9)", s.Min(), s.Max())
+	}
+	if s.Erase(2) {
+		t.Errorf("Erase(2) returns true for not inserted value")
+	}
+	if !s.Erase(1) || s.Count(1) != 1 || s.Len() != 5 {
+		t.Errorf("after Erase(1): Count(1) = %d, Len() = %d", s.Count(1), s.Len())
+	}
+}
+
+func TestSortedMultisetAAA_Split(t *testing.T) {
+	// 昇順に追加すると全て末尾のbucketに入るため、bucketの分割が起こる
+	n := 4*sortedMultisetAAABucketSize + 1
+	s := NewSortedMultisetAAA()
+	for i := 0; i < n; i++ {
+		s.Insert(AAA(i))
+	}
+	if len(s.buckets) < 3 {
+		t.Fatalf("len(buckets) = %d after %d ascending inserts, want at least 3", len(s.buckets), n)
+	}
+	for _, b := range s.buckets {
+		if len(b) > 2*sortedMultisetAAABucketSize {
+			t.Fatalf("bucket length %d exceeds %d", len(b), 2*sortedMultisetAAABucketSize)
+		}
+	}
+	for _, k := range []int{0, sortedMultisetAAABucketSize, 2 * sortedMultisetAAABucketSize, n - 1} {
+		if got := s.Kth(k); got != AAA(k) {
+			t.Errorf("Kth(%d) = %v, want %v", k, got, k)
+		}
+		if got := s.Rank(AAA(k)); got != k {
+			t.Errorf("Rank(%v) = %d, want %d", k, got, k)
+		}
+	}
+
+	// 先頭のbucketの要素を全て削除すると、bucketごと取り除かれる
+	bucketNum, first := len(s.buckets), append([]AAA{}, s.buckets[0]...)
+	for _, v := range first {
+		if !s.Erase(v) {
+			t.Fatalf("Erase(%v) = false, want true", v)
+		}
+	}
+	if len(s.buckets) != bucketNum-1 {
+		t.Errorf("len(buckets) = %d after erasing first bucket, want %d", len(s.buckets), bucketNum-1)
+	}
+	if got, want := s.Min(), AAA(len(first)); got != want {
+		t.Errorf("Min() = %v, want %v", got, want)
+	}
+	if got, want := s.Len(), n-len(first); got != want {
+		t.Errorf("Len() = %d, want %d", got, want)
+	}
+	for i, v := range s.Values() {
+		if want := AAA(len(first) + i); v != want {
+			t.Fatalf("Values()[%d] = %v, want %v", i, v, want)
+		}
+	}
+}
+
+func TestSortedMultisetInt_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	initial := randomIntSlice(r, 1000, 0, 300)
+	s := NewSortedMultisetInt(initial...)
+	want := append([]int{}, initial...)
+	sort.Ints(want)
+
+	for i := 0; i < 2000; i++ {
+		v := r.Intn(300)
+		switch r.Intn(3) {
+		case 0:
+			s.Insert(v)
+			j := UpperBoundInt(want, v)
+			want = append(want[:j], append([]int{v}, want[j:]...)...)
+		case 1:
+			j := LowerBoundInt(want, v)
+			found := j < len(want) && want[j] == v
+			if got := s.Erase(v); got != found {
+				t.Fatalf("Erase(%d) = %v, want %v", v, got, found)
+			}
+			if found {
+				want = append(want[:j], want[j+1:]...)
+			}
+		case 2:
+			lb, ub := LowerBoundInt(want, v), UpperBoundInt(want, v)
+			if got := s.Rank(v); got != lb {
+				t.Fatalf("Rank(%d) = %d, want %d", v, got, lb)
+			}
+			if got := s.Count(v); got != ub-lb {
+				t.Fatalf("Count(%d) = %d, want %d", v, got, ub-lb)
+			}
+			if got := s.Contains(v); got != (ub > lb) {
+				t.Fatalf("Contains(%d) = %v, want %v", v, got, ub > lb)
+			}
+			if got, ok := s.LowerBound(v); ok != (lb < len(want)) || (ok && got != want[lb]) {
+				t.Fatalf("LowerBound(%d) = (%d, %v)", v, got, ok)
+			}
+			if got, ok := s.UpperBound(v); ok != (ub < len(want)) || (ok && got != want[ub]) {
+				t.Fatalf("UpperBound(%d) = (%d, %v)", v, got, ok)
+			}
+			if len(want) > 0 {
+				k := r.Intn(len(want))
+				if got := s.Kth(k); got != want[k] {
+					t.Fatalf("Kth(%d) = %d, want %d", k, got, want[k])
+				}
+			}
+		}
+		if s.Len() != len(want) {
+			t.Fatalf("Len() = %d, want %d", s.Len(), len(want))
+		}
+		if len(want) > 0 && (s.Min() != want[0] || s.Max() != want[len(want)-1]) {
+			t.Fatalf("(Min(), Max()) = (%d, %d), want (%d, %d)", s.Min(), s.Max(), want[0], want[len(want)-1])
+		}
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
+
+func TestSortedMultisetInt_Each(t *testing.T) {
+	s := NewSortedMultisetInt()
+	for _, v := range []int{5, 1, 3, 1, 4} {
+		s.Insert(v)
+	}
+	var got []int
+	s.Each(func(v int) bool {
+		got = append(got, v)
+		return v < 3
+	})
+	if want := []int{1, 1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got = %v, want %v", got, want)
+	}
+	for s.Len() > 0 {
+		s.Erase(s.Min())
+	}
+	if _, ok := s.LowerBound(0); ok {
+		t.Errorf("LowerBound() on empty multiset returns ok")
+	}
+}
@@ -42,15 +42,16 @@ func FilterAAASlice(values [][]AAA, f func(v []AAA) bool) (newValues [][]AAA) {
 	return
 }
 
-// UniqAAA は、与えられた値から重複を取り除いて返します.
+// UniqAAA は、与えられた値から重複を取り除いて返します. 各値は最初に現れた順に並びます.
+// 昇順に並んだ値の場合は、mapを使わないUniqSortedAAAの方が高速です.
 func UniqAAA(values []AAA) (newValues []AAA) {
 	m := map[AAA]bool{}
 	for _, value := range values {
+		if m[value] {
+			continue
+		}
 		m[value] = true
-	}
-
-	for key := range m {
-		newValues = append(newValues, key)
+		newValues = append(newValues, value)
 	}
 	return
 }
@@ -144,6 +144,7 @@ func ModSum(a, b, mod int) int {
 }
 
 // ModMul はa*bをmodで割ったあまりを返します
+// (a%mod)*(b%mod)がintの範囲を超える場合は正しく計算できないため、MulModUint64を利用してください
 func ModMul(a, b, mod int) int {
 	return (a % mod) * (b % mod) % mod
 }
@@ -3,7 +3,6 @@ package lib
 import (
 	"fmt"
 	"reflect"
-	"sort"
 	"testing"
 )
 
@@ -108,16 +107,17 @@ func TestUniqValue(t *testing.T) {
 			},
 			wantNewValues: []AAA{1, 2, 3},
 		},
+		{
+			name: "uniq keeps first occurrence order",
+			args: args{
+				values: []AAA{3, 1, 3, 2, 1},
+			},
+			wantNewValues: []AAA{3, 1, 2},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gotNewValues := UniqAAA(tt.args.values)
-			sort.SliceStable(gotNewValues, func(i, j int) bool {
-				return gotNewValues[i] < gotNewValues[j]
-			})
-			sort.SliceStable(tt.wantNewValues, func(i, j int) bool {
-				return tt.wantNewValues[i] < tt.wantNewValues[j]
-			})
 			if !reflect.DeepEqual(gotNewValues, tt.wantNewValues) {
 				t.Errorf("UniqAAA() = %v, want %v", gotNewValues, tt.wantNewValues)
 			}
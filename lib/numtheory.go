@@ -0,0 +1,152 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ExtGcd は、拡張ユークリッドの互除法により、a*x + b*y = gを満たすgcd(a, b)と整数x, yを返します.
+// gは0以上です. a, bが負の値でも利用できます.
+func ExtGcd(a, b int) (g, x, y int) {
+	x0, y0, x1, y1 := 1, 0, 0, 1
+	for b != 0 {
+		q := a / b
+		a, b = b, a-q*b
+		x0, x1 = x1, x0-q*x1
+		y0, y1 = y1, y0-q*y1
+	}
+	if a < 0 {
+		return -a, -x0, -y0
+	}
+	return a, x0, y0
+}
+
+// Lcm は、2つ以上の0以上の数の最小公倍数を返します. いずれかが0の場合は0を返します.
+// 結果がintの範囲を超える場合や、負の値が与えられた場合はエラーを返します.
+func Lcm(a, b int, values ...int) (int, error) {
+	values = append([]int{a, b}, values...)
+	for _, v := range values {
+		if v < 0 {
+			return 0, fmt.Errorf("negative value is given: %d", v)
+		}
+	}
+	l := values[0]
+	for _, v := range values[1:] {
+		if l == 0 || v == 0 {
+			l = 0
+			continue
+		}
+		d := v / gcd(l, v)
+		if l > math.MaxInt64/d {
+			return 0, fmt.Errorf("lcm overflows: lcm(%d, %d)", l, v)
+		}
+		l *= d
+	}
+	return l, nil
+}
+
+// mulMod は、a*bをmodで割ったあまりを返します. a, bは0以上である必要があります.
+func mulMod(a, b, mod int) int {
+	return int(MulModUint64(uint64(a), uint64(b), uint64(mod)))
+}
+
+// ModInv は、a*x ≡ 1 (mod mod)を満たす0以上mod未満のxを返します.
+// modは素数である必要はありませんが、aとmodが互いに素でない場合はエラーを返します.
+func ModInv(a, mod int) (int, error) {
+	if mod < 1 {
+		return 0, fmt.Errorf("mod must be positive: %d", mod)
+	}
+	g, x, _ := ExtGcd(a%mod, mod)
+	if g != 1 {
+		return 0, fmt.Errorf("%d and %d are not coprime", a, mod)
+	}
+	return (x%mod + mod) % mod, nil
+}
+
+// CRT は、中国剰余定理により、全てのiについてx ≡ remainders[i] (mod mods[i])を満たすxを、x ≡ r (mod m)の形で返します.
+// mは全てのmodsの最小公倍数で、rは0以上m未満です. modsは互いに素である必要はありません.
+// 解が存在しない場合、mがintの範囲を超える場合、modsに1未満の値が含まれる場合はエラーを返します.
+func CRT(remainders, mods []int) (r, m int, err error) {
+	if len(remainders) != len(mods) {
+		return 0, 0, fmt.Errorf("remainders and mods lengths are different. remainders:%d mods:%d", len(remainders), len(mods))
+	}
+	r, m = 0, 1
+	for i, mi := range mods {
+		if mi < 1 {
+			return 0, 0, fmt.Errorf("%dth mod must be positive: %d", i, mi)
+		}
+		ri := (remainders[i]%mi + mi) % mi
+		r0, m0, r1, m1 := r, m, ri, mi
+		if m0 < m1 {
+			r0, m0, r1, m1 = r1, m1, r0, m0
+		}
+		if m0%m1 == 0 {
+			if r0%m1 != r1 {
+				return 0, 0, errors.New("no solution")
+			}
+			r, m = r0, m0
+			continue
+		}
+
+		// m0*x ≡ r1-r0 (mod m1)を解く
+		g, inv, _ := ExtGcd(m0, m1)
+		if (r1-r0)%g != 0 {
+			return 0, 0, errors.New("no solution")
+		}
+		u1 := m1 / g
+		if m0 > math.MaxInt64/u1 {
+			return 0, 0, fmt.Errorf("lcm of mods overflows")
+		}
+		diff := ((r1-r0)/g%u1 + u1) % u1
+		x := mulMod(diff, (inv%u1+u1)%u1, u1)
+		r, m = r0+x*m0, m0*u1
+	}
+	return r, m, nil
+}
+
+// DiscreteLog は、x^k ≡ y (mod mod)を満たす最小の0以上の整数kを、Baby-step Giant-step法により返します.
+// xとmodは互いに素である必要はありません. 解が存在しない場合はエラーを返します. 計算量はO(√mod)です.
+func DiscreteLog(x, y, mod int) (int, error) {
+	if mod < 1 {
+		return 0, fmt.Errorf("mod must be positive: %d", mod)
+	}
+	x, y = (x%mod+mod)%mod, (y%mod+mod)%mod
+	if mod == 1 {
+		return 0, nil
+	}
+
+	// xとmodが互いに素になるまで両辺をgcdで割る. k < addの解はここで見つかる
+	k, add := 1, 0
+	for g := gcd(x, mod); g > 1; g = gcd(x, mod) {
+		if y == k {
+			return add, nil
+		}
+		if y%g != 0 {
+			return 0, errors.New("no solution")
+		}
+		y, mod = y/g, mod/g
+		add++
+		k = mulMod(k, x/g, mod)
+		x %= mod
+	}
+
+	// k * x^(n*p - q) ≡ y となるp, qを探す
+	n := int(math.Sqrt(float64(mod))) + 1
+	baby := make(map[int]int, n+1)
+	for q, cur := 0, y; q <= n; q++ {
+		baby[cur] = q
+		cur = mulMod(cur, x, mod)
+	}
+	xn := 1
+	for i := 0; i < n; i++ {
+		xn = mulMod(xn, x, mod)
+	}
+	for p, cur := 1, k; p <= n; p++ {
+		cur = mulMod(cur, xn, mod)
+		if q, ok := baby[cur]; ok {
+			return n*p - q + add, nil
+		}
+	}
+	return 0, errors.New("no solution")
+}
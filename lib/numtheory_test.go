@@ -0,0 +1,242 @@
+package lib
+
+import (
+	"testing"
+)
+
+func TestExtGcd(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  int
+		wantG int
+	}{
+		{name: "ExtGcd", a: 3, b: 5, wantG: 1},
+		{name: "ExtGcd", a: 12, b: 18, wantG: 6},
+		{name: "ExtGcd", a: -12, b: 18, wantG: 6},
+		{name: "ExtGcd", a: 12, b: -18, wantG: 6},
+		{name: "ExtGcd", a: 0, b: 5, wantG: 5},
+		{name: "ExtGcd", a: 7, b: 0, wantG: 7},
+		{name: "ExtGcd", a: 0, b: 0, wantG: 0},
+		{name: "ExtGcd", a: 1000000007, b: 998244353, wantG: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, x, y := ExtGcd(tt.a, tt.b)
+			if g != tt.wantG || tt.a*x+tt.b*y != g {
+				t.Errorf("ExtGcd(%d, %d) = (%d, %d, %d), want g = %d and a*x+b*y = g", tt.a, tt.b, g, x, y, tt.wantG)
+			}
+		})
+	}
+}
+
+func TestLcm(t *testing.T) {
+	type args struct {
+		a      int
+		b      int
+		values []int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Lcm",
+			args: args{a: 4, b: 6},
+			want: 12,
+		},
+		{
+			name: "Lcm",
+			args: args{a: 2, b: 3, values: []int{4, 5}},
+			want: 60,
+		},
+		{
+			name: "Lcm",
+			args: args{a: 0, b: 3},
+			want: 0,
+		},
+		{
+			name: "Lcm",
+			args: args{a: 1000000007, b: 998244353},
+			want: 998244359987710471,
+		},
+		{
+			name:    "Lcm",
+			args:    args{a: 1000000007, b: 998244353, values: []int{10}},
+			wantErr: true,
+		},
+		{
+			name:    "Lcm",
+			args:    args{a: -2, b: 3},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Lcm(tt.args.a, tt.args.b, tt.args.values...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Lcm() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Lcm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModInv(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, mod  int
+		want    int
+		wantErr bool
+	}{
+		{name: "ModInv", a: 3, mod: 7, want: 5},
+		{name: "ModInv", a: -3, mod: 7, want: 2},
+		{name: "ModInv", a: 10, mod: 7, want: 5},
+		{name: "ModInv", a: 2, mod: 1000000007, want: 500000004},
+		{
+			// 法が素数でなくても、互いに素であれば逆元が存在する
+			name: "ModInv",
+			a:    5,
+			mod:  12,
+			want: 5,
+		},
+		{name: "ModInv", a: 3, mod: 1, want: 0},
+		{name: "ModInv", a: 4, mod: 6, wantErr: true},
+		{name: "ModInv", a: 0, mod: 5, wantErr: true},
+		{name: "ModInv", a: 1, mod: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ModInv(tt.a, tt.mod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ModInv() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ModInv() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		name       string
+		remainders []int
+		mods       []int
+		wantR      int
+		wantM      int
+		wantErr    bool
+	}{
+		{
+			name:       "CRT",
+			remainders: []int{2, 3, 2},
+			mods:       []int{3, 5, 7},
+			wantR:      23,
+			wantM:      105,
+		},
+		{
+			name:       "CRT",
+			remainders: []int{1, 3},
+			mods:       []int{4, 6},
+			wantR:      9,
+			wantM:      12,
+		},
+		{
+			name:       "CRT",
+			remainders: []int{1, 2},
+			mods:       []int{4, 6},
+			wantErr:    true,
+		},
+		{
+			name:       "CRT",
+			remainders: []int{-1, 5},
+			mods:       []int{1000000007, 998244353},
+			wantR:      968751683781261738,
+			wantM:      998244359987710471,
+		},
+		{
+			// 余りが法以上
+			name:       "CRT",
+			remainders: []int{7, 12},
+			mods:       []int{5, 3},
+			wantR:      12,
+			wantM:      15,
+		},
+		{
+			name:       "CRT",
+			remainders: []int{3},
+			mods:       []int{1},
+			wantR:      0,
+			wantM:      1,
+		},
+		{
+			name:       "CRT",
+			remainders: []int{},
+			mods:       []int{},
+			wantR:      0,
+			wantM:      1,
+		},
+		{
+			name:       "CRT",
+			remainders: []int{0, 0, 0},
+			mods:       []int{1000000007, 998244353, 10},
+			wantErr:    true,
+		},
+		{
+			name:       "CRT",
+			remainders: []int{1},
+			mods:       []int{0},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotR, gotM, err := CRT(tt.remainders, tt.mods)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CRT() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotR != tt.wantR || gotM != tt.wantM {
+				t.Errorf("CRT() = (%v, %v), want (%v, %v)", gotR, gotM, tt.wantR, tt.wantM)
+			}
+		})
+	}
+
+}
+
+func TestDiscreteLog(t *testing.T) {
+	// 法が素数でない場合や、xとmodが互いに素でない場合も含めて、小さい法で全ての組み合わせを確かめる
+	for mod := 1; mod <= 20; mod++ {
+		for x := 0; x < mod; x++ {
+			for y := 0; y < mod; y++ {
+				want, cur := -1, 1%mod
+				for k := 0; k <= mod; k++ {
+					if cur == y {
+						want = k
+						break
+					}
+					cur = cur * x % mod
+				}
+				got, err := DiscreteLog(x, y, mod)
+				if want < 0 {
+					if err == nil {
+						t.Fatalf("DiscreteLog(%d, %d, %d) error = nil, want error", x, y, mod)
+					}
+					continue
+				}
+				if err != nil || got != want {
+					t.Fatalf("DiscreteLog(%d, %d, %d) = (%d, %v), want %d", x, y, mod, got, err, want)
+				}
+			}
+		}
+	}
+
+	if got := MustDiscreteLog(3, ModPow(3, 123456789, 1000000007), 1000000007); ModPow(3, got, 1000000007) != ModPow(3, 123456789, 1000000007) || got > 123456789 {
+		t.Errorf("DiscreteLog() = %d", got)
+	}
+}
@@ -0,0 +1,165 @@
+package lib
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// MulModUint64 は、a*bをmodで割ったあまりを返します.
+// 128bitの積を利用するため、ModMulと異なりa*bがint64の範囲を超える場合も正しく計算できます.
+func MulModUint64(a, b, mod uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, rem := bits.Div64(hi%mod, lo, mod)
+	return rem
+}
+
+// PowModUint64 は、aのn乗をmodで割ったあまりを返します. modが2^63を超える場合も利用できます.
+func PowModUint64(a, n, mod uint64) uint64 {
+	a %= mod
+	res := 1 % mod
+	for n > 0 {
+		if n&1 == 1 {
+			res = MulModUint64(res, a, mod)
+		}
+		a = MulModUint64(a, a, mod)
+		n >>= 1
+	}
+	return res
+}
+
+// millerRabinBases は、2^64未満の整数に対して決定的に素数判定ができる底です.
+var millerRabinBases = []uint64{2, 325, 9375, 28178, 450775, 9780504, 1795265022}
+
+// IsPrimeUint64 は、nが素数であるかを決定的Miller-Rabin法で判定します. 計算量はO(log n)です.
+func IsPrimeUint64(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37} {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+
+	d := n - 1
+	s := bits.TrailingZeros64(d)
+	d >>= uint(s)
+	for _, a := range millerRabinBases {
+		a %= n
+		if a == 0 {
+			continue
+		}
+		x := PowModUint64(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		composite := true
+		for i := 1; i < s; i++ {
+			x = MulModUint64(x, x, n)
+			if x == n-1 {
+				composite = false
+				break
+			}
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+func gcdUint64(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// PollardRho は、合成数nの1とn以外の約数を1つ返します. nが素数または1以下の場合はnを返します.
+// Brentの改良を加えたPollardのρ法を利用し、期待計算量はO(n^(1/4) log n)です.
+func PollardRho(n uint64) uint64 {
+	if n <= 1 {
+		return n
+	}
+	if n%2 == 0 {
+		return 2
+	}
+	if IsPrimeUint64(n) {
+		return n
+	}
+	const m = 128
+	for c := uint64(1); ; c++ {
+		f := func(x uint64) uint64 {
+			// nが2^64に近い場合にオーバーフローしないよう、cを法nの下で加算する
+			s := MulModUint64(x, x, n)
+			if s >= n-c {
+				return s - (n - c)
+			}
+			return s + c
+		}
+		var x, y, ys, q uint64 = 0, 2, 0, 1
+		g := uint64(1)
+		for r := 1; g == 1; r <<= 1 {
+			x = y
+			for i := 0; i < r; i++ {
+				y = f(y)
+			}
+			for k := 0; k < r && g == 1; k += m {
+				ys = y
+				for i := 0; i < m && i < r-k; i++ {
+					y = f(y)
+					diff := x - y
+					if x < y {
+						diff = y - x
+					}
+					q = MulModUint64(q, diff, n)
+				}
+				g = gcdUint64(q, n)
+			}
+		}
+		if g == n {
+			for g = 1; g == 1; {
+				ys = f(ys)
+				diff := x - ys
+				if x < ys {
+					diff = ys - x
+				}
+				g = gcdUint64(diff, n)
+			}
+		}
+		if g != n {
+			return g
+		}
+	}
+}
+
+// PrimeFactorsUint64 は、nを素因数分解した結果を昇順で返します(例: 12 -> [2 2 3]).
+// 10^18程度の整数でも高速に素因数分解できます. nが1以下の場合は空のsliceを返します.
+func PrimeFactorsUint64(n uint64) []uint64 {
+	if n <= 1 {
+		return nil
+	}
+	var pfs []uint64
+	for _, p := range []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37} {
+		for n%p == 0 {
+			pfs = append(pfs, p)
+			n /= p
+		}
+	}
+	stack := []uint64{n}
+	for len(stack) > 0 {
+		x := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if x == 1 {
+			continue
+		}
+		if IsPrimeUint64(x) {
+			pfs = append(pfs, x)
+			continue
+		}
+		d := PollardRho(x)
+		stack = append(stack, d, x/d)
+	}
+	sort.Slice(pfs, func(i, j int) bool { return pfs[i] < pfs[j] })
+	return pfs
+}
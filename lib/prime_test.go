@@ -0,0 +1,215 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMulModUint64(t *testing.T) {
+	type args struct {
+		a   uint64
+		b   uint64
+		mod uint64
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint64
+	}{
+		{
+			name: "MulModUint64",
+			args: args{a: 3, b: 4, mod: 5},
+			want: 2,
+		},
+		{
+			name: "MulModUint64",
+			args: args{a: 999999999999999999, b: 999999999999999999, mod: 1000000000000000003},
+			want: 16,
+		},
+		{
+			name: "MulModUint64",
+			args: args{a: 1<<64 - 1, b: 1<<64 - 1, mod: 1<<64 - 59},
+			want: 3364,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MulModUint64(tt.args.a, tt.args.b, tt.args.mod); got != tt.want {
+				t.Errorf("MulModUint64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPowModUint64(t *testing.T) {
+	type args struct {
+		a   uint64
+		n   uint64
+		mod uint64
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint64
+	}{
+		{
+			name: "PowModUint64",
+			args: args{a: 2, n: 10, mod: 1000},
+			want: 24,
+		},
+		{
+			name: "PowModUint64",
+			args: args{a: 5, n: 0, mod: 1},
+			want: 0,
+		},
+		{
+			// フェルマーの小定理
+			name: "PowModUint64",
+			args: args{a: 123456789, n: 1000000000000000002, mod: 1000000000000000003},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PowModUint64(tt.args.a, tt.args.n, tt.args.mod); got != tt.want {
+				t.Errorf("PowModUint64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPrimeUint64(t *testing.T) {
+	s := MustNewSieve(100000)
+	for x := 0; x <= 100000; x++ {
+		if got, want := IsPrimeUint64(uint64(x)), s.IsPrime(x); got != want {
+			t.Fatalf("IsPrimeUint64(%d) = %v, want %v", x, got, want)
+		}
+	}
+
+	tests := []struct {
+		name string
+		n    uint64
+		want bool
+	}{
+		{name: "IsPrimeUint64", n: 999999999999999989, want: true},
+		{name: "IsPrimeUint64", n: 1000000000000000003, want: true},
+		{name: "IsPrimeUint64", n: 1<<61 - 1, want: true},
+		{name: "IsPrimeUint64", n: 18446744073709551557, want: true},
+		// 2, 3, 5, 7を底とする強擬素数
+		{name: "IsPrimeUint64", n: 3215031751, want: false},
+		// 2から23までを底とする強擬素数
+		{name: "IsPrimeUint64", n: 3825123056546413051, want: false},
+		{name: "IsPrimeUint64", n: 4295098369, want: false},
+		{name: "IsPrimeUint64", n: 1000000007 * 998244353, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPrimeUint64(tt.n); got != tt.want {
+				t.Errorf("IsPrimeUint64(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrimeFactorsUint64(t *testing.T) {
+	tests := []struct {
+		name string
+		n    uint64
+		want []uint64
+	}{
+		{
+			name: "PrimeFactorsUint64",
+			n:    2,
+			want: []uint64{2},
+		},
+		{
+			name: "PrimeFactorsUint64",
+			n:    360,
+			want: []uint64{2, 2, 2, 3, 3, 5},
+		},
+		{
+			name: "PrimeFactorsUint64",
+			n:    2999,
+			want: []uint64{2999},
+		},
+		{
+			name: "PrimeFactorsUint64",
+			n:    1000000007 * 998244353,
+			want: []uint64{998244353, 1000000007},
+		},
+		{
+			name: "PrimeFactorsUint64",
+			n:    4295098369,
+			want: []uint64{65537, 65537},
+		},
+		{
+			name: "PrimeFactorsUint64",
+			n:    999999999999999989,
+			want: []uint64{999999999999999989},
+		},
+		{
+			name: "PrimeFactorsUint64",
+			n:    1000000000000000000,
+			want: []uint64{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
+		},
+		{
+			name: "PrimeFactorsUint64",
+			n:    3825123056546413051,
+			want: []uint64{149491, 747451, 34233211},
+		},
+		{
+			name: "2^64に近い合成数",
+			n:    4294967291 * 4294967279,
+			want: []uint64{4294967279, 4294967291},
+		},
+		{
+			name: "2^64に近い素数",
+			n:    18446744073709551557,
+			want: []uint64{18446744073709551557},
+		},
+		{
+			name: "1",
+			n:    1,
+			want: nil,
+		},
+		{
+			name: "0",
+			n:    0,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PrimeFactorsUint64(tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrimeFactorsUint64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPollardRho(t *testing.T) {
+	tests := []struct {
+		name string
+		n    uint64
+		want []uint64
+	}{
+		{name: "0", n: 0, want: []uint64{0}},
+		{name: "1", n: 1, want: []uint64{1}},
+		{name: "prime", n: 998244353, want: []uint64{998244353}},
+		{name: "even", n: 1 << 40, want: []uint64{2}},
+		{name: "semiprime", n: 1000000007 * 998244353, want: []uint64{998244353, 1000000007}},
+		{name: "2^64に近い合成数", n: 4294967291 * 4294967279, want: []uint64{4294967279, 4294967291}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PollardRho(tt.n)
+			found := false
+			for _, w := range tt.want {
+				found = found || got == w
+			}
+			if !found {
+				t.Errorf("PollardRho(%d) = %d, want one of %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
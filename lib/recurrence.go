@@ -0,0 +1,119 @@
+package lib
+
+import "fmt"
+
+// BerlekampMassey は、素数modの下で数列sを生成する最短の線形漸化式 s[i] = Σ_{j=0}^{d-1} c[j]*s[i-1-j] の係数cを返します.
+// 漸化式の次数がdの場合、sは少なくとも2d項必要です. 計算量はO(len(s)^2)です.
+func BerlekampMassey(s []int, mod int) []int {
+	s = normalizeMod(s, mod)
+	// C(x) = 1 + c'[1]x + ... が現在の漸化式、B(x)が最後に次数が変わる直前の漸化式
+	b, c := []int{1}, []int{1}
+	l, m, bd := 0, 1, 1
+	for i := range s {
+		d := s[i]
+		for j := 1; j <= l && j < len(c); j++ {
+			d = (d + c[j]*s[i-j]) % mod
+		}
+		if d == 0 {
+			m++
+			continue
+		}
+		coef := d * ModPow(bd, mod-2, mod) % mod
+		prev := append([]int{}, c...)
+		for len(c) < len(b)+m {
+			c = append(c, 0)
+		}
+		for j, v := range b {
+			c[j+m] = (c[j+m] - coef*v%mod + mod) % mod
+		}
+		if 2*l <= i {
+			l, b, bd, m = i+1-l, prev, d, 1
+		} else {
+			m++
+		}
+	}
+
+	res := make([]int, l)
+	for j := range res {
+		if j+1 < len(c) {
+			res[j] = (mod - c[j+1]) % mod
+		}
+	}
+	return res
+}
+
+// LinearRecurrenceKth は、先頭の項がaで、i >= len(c)について a[i] = Σ_{j=0}^{len(c)-1} c[j]*a[i-1-j] を満たす数列の第k項(0始まり)をmodで割ったあまりを返します.
+// Bostan-Moriのアルゴリズムにより、計算量はd=len(c)としてO(d log d log k)です. modは素数である必要はありませんが、2^31以下である必要があります.
+func LinearRecurrenceKth(a, c []int, k, mod int) (int, error) {
+	if err := validateRecurrenceMod(mod); err != nil {
+		return 0, err
+	}
+	d := len(c)
+	if len(a) < d {
+		return 0, fmt.Errorf("a must have at least len(c)(%d) terms: %d", d, len(a))
+	}
+	if k < 0 {
+		return 0, fmt.Errorf("negative k is given: %d", k)
+	}
+	if k < len(a) {
+		return (a[k]%mod + mod) % mod, nil
+	}
+	if d == 0 {
+		return 0, nil
+	}
+
+	// 母関数をP(x)/Q(x)として、Q(x) = 1 - Σ c[j]x^(j+1), P(x) = A(x)Q(x) mod x^d
+	q := make([]int, d+1)
+	q[0] = 1 % mod
+	for j, v := range c {
+		q[j+1] = (mod - (v%mod+mod)%mod) % mod
+	}
+	p, err := ConvolutionMod(a[:d], q, mod)
+	if err != nil {
+		return 0, err
+	}
+	p = p[:d]
+
+	// P(x)/Q(x) = P(x)Q(-x)/Q(x)Q(-x)として、分母を偶関数にしてkを半分にすることを繰り返す
+	for k > 0 {
+		qm := append([]int{}, q...)
+		for j := 1; j < len(qm); j += 2 {
+			qm[j] = (mod - qm[j]) % mod
+		}
+		u, err := ConvolutionMod(p, qm, mod)
+		if err != nil {
+			return 0, err
+		}
+		v, err := ConvolutionMod(q, qm, mod)
+		if err != nil {
+			return 0, err
+		}
+		p, q = p[:0], q[:0]
+		for j := k & 1; j < len(u); j += 2 {
+			p = append(p, u[j])
+		}
+		for j := 0; j < len(v); j += 2 {
+			q = append(q, v[j])
+		}
+		k >>= 1
+	}
+	// Q(0)は常に1になる
+	return p[0], nil
+}
+
+// GuessKthTerm は、数列の先頭の項sから最短の線形漸化式をBerlekampMasseyで推測し、第k項(0始まり)を素数modで割ったあまりを返します.
+// 実験で得た数列の一般項を求める場合などに利用できます. 漸化式の次数がdの場合、sは少なくとも2d項必要です.
+func GuessKthTerm(s []int, k, mod int) (int, error) {
+	if err := validateRecurrenceMod(mod); err != nil {
+		return 0, err
+	}
+	return LinearRecurrenceKth(s, BerlekampMassey(s, mod), k, mod)
+}
+
+// validateRecurrenceMod は、modが1以上2^31以下でない場合にエラーを返します.
+func validateRecurrenceMod(mod int) error {
+	if mod < 1 || mod > 1<<31 {
+		return fmt.Errorf("mod must be in [1, 2^31]: %d", mod)
+	}
+	return nil
+}
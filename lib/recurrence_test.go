@@ -0,0 +1,186 @@
+package lib
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestBerlekampMassey(t *testing.T) {
+	const mod = 998244353
+	tests := []struct {
+		name string
+		s    []int
+		want []int
+	}{
+		{
+			name: "BerlekampMassey",
+			s:    []int{0, 1, 1, 2, 3, 5, 8, 13},
+			want: []int{1, 1},
+		},
+		{
+			name: "BerlekampMassey",
+			s:    []int{1, 2, 4, 8, 16},
+			want: []int{2},
+		},
+		{
+			name: "BerlekampMassey",
+			s:    []int{0, 0, 0},
+			want: []int{},
+		},
+		{
+			// a[i] = 2a[i-1] - a[i-2] + 0*a[i-3]ではなく、最短の漸化式を返す
+			name: "BerlekampMassey",
+			s:    []int{1, 2, 3, 4, 5, 6},
+			want: []int{2, mod - 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BerlekampMassey(tt.s, mod); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BerlekampMassey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinearRecurrenceKth(t *testing.T) {
+	type args struct {
+		a   []int
+		c   []int
+		k   int
+		mod int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "LinearRecurrenceKth",
+			args: args{a: []int{0, 1}, c: []int{1, 1}, k: 10, mod: 998244353},
+			want: 55,
+		},
+		{
+			name: "LinearRecurrenceKth",
+			args: args{a: []int{0, 1}, c: []int{1, 1}, k: 1000000000000000000, mod: 998244353},
+			want: 23849548,
+		},
+		{
+			name: "LinearRecurrenceKth",
+			args: args{a: []int{1}, c: []int{2}, k: 100, mod: 1000000007},
+			want: 976371285,
+		},
+		{
+			// modが素数でない場合
+			name: "LinearRecurrenceKth",
+			args: args{a: []int{0, 0, 1}, c: []int{1, 1, 1}, k: 37, mod: 1 << 20},
+			want: 1023348,
+		},
+		{
+			// aがlen(c)より多くの項を持つ場合
+			name: "LinearRecurrenceKth",
+			args: args{a: []int{0, 1, 1, 2}, c: []int{1, 1}, k: 6, mod: 998244353},
+			want: 8,
+		},
+		{
+			name: "LinearRecurrenceKth",
+			args: args{a: []int{3, 1, 4}, c: []int{1, 1}, k: 2, mod: 998244353},
+			want: 4,
+		},
+		{
+			name: "LinearRecurrenceKth",
+			args: args{a: []int{}, c: []int{}, k: 5, mod: 998244353},
+			want: 0,
+		},
+		{
+			name:    "LinearRecurrenceKth",
+			args:    args{a: []int{1}, c: []int{1, 1}, k: 5, mod: 7},
+			wantErr: true,
+		},
+		{
+			name:    "LinearRecurrenceKth",
+			args:    args{a: []int{0, 1}, c: []int{1, 1}, k: 1, mod: 0},
+			wantErr: true,
+		},
+		{
+			name:    "LinearRecurrenceKth",
+			args:    args{a: []int{0, 1}, c: []int{1, 1}, k: 5, mod: 1<<31 + 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LinearRecurrenceKth(tt.args.a, tt.args.c, tt.args.k, tt.args.mod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LinearRecurrenceKth() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("LinearRecurrenceKth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinearRecurrenceKth_Random(t *testing.T) {
+	// 次数の大きい漸化式について、愚直に計算した項と比較する
+	r := rand.New(rand.NewSource(1))
+	const mod = 998244353
+	for i := 0; i < 10; i++ {
+		d := 1 + r.Intn(80)
+		c := randomIntSlice(r, d, 0, mod)
+		seq := randomIntSlice(r, d, 0, mod)
+		for len(seq) < 300 {
+			v := 0
+			for j, cj := range c {
+				v = (v + cj*seq[len(seq)-1-j]) % mod
+			}
+			seq = append(seq, v)
+		}
+		if got := MustLinearRecurrenceKth(seq[:d], c, len(seq)-1, mod); got != seq[len(seq)-1] {
+			t.Fatalf("LinearRecurrenceKth(%v, %v, %d, %d) = %d, want %d", seq[:d], c, len(seq)-1, mod, got, seq[len(seq)-1])
+		}
+	}
+}
+
+func TestGuessKthTerm(t *testing.T) {
+	const mod = 998244353
+	tests := []struct {
+		name string
+		s    []int
+		k    int
+		want int
+	}{
+		{
+			name: "GuessKthTerm",
+			s:    []int{0, 1, 1, 2, 3, 5},
+			k:    100000,
+			want: 10519474,
+		},
+		{
+			name: "GuessKthTerm",
+			s:    []int{1, 2, 4, 8},
+			k:    30,
+			want: 75497471,
+		},
+		{
+			// 平方数は3次の漸化式を満たす
+			name: "GuessKthTerm",
+			s:    []int{0, 1, 4, 9, 16, 25, 36, 49},
+			k:    1000,
+			want: 1000000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MustGuessKthTerm(tt.s, tt.k, mod); got != tt.want {
+				t.Errorf("GuessKthTerm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := GuessKthTerm([]int{0, 1, 1, 2}, 10, 0); err == nil {
+		t.Errorf("GuessKthTerm() with mod 0 error = nil, want error")
+	}
+}
@@ -0,0 +1,181 @@
+package lib
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"time"
+)
+
+// RollingHashMod は、ローリングハッシュの法2^61-1です. 法が大きいため、衝突を狙ったテストケースに対しても安全です.
+const RollingHashMod = 1<<61 - 1
+
+// rollingHashMul は、a*bをRollingHashModで割ったあまりを返します. a, bはRollingHashMod未満である必要があります.
+func rollingHashMul(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	// 2^61 ≡ 1 であることを利用して、128bitの積を61bitごとに分けて足し合わせる
+	res := (hi<<3 | lo>>61) + lo&RollingHashMod
+	res = res>>61 + res&RollingHashMod
+	if res >= RollingHashMod {
+		res -= RollingHashMod
+	}
+	return res
+}
+
+// rollingHashAdd は、a+bをRollingHashModで割ったあまりを返します.
+func rollingHashAdd(a, b uint64) uint64 {
+	res := a + b
+	if res >= RollingHashMod {
+		res -= RollingHashMod
+	}
+	return res
+}
+
+// rollingHashSub は、a-bをRollingHashModで割ったあまりを返します.
+func rollingHashSub(a, b uint64) uint64 {
+	return rollingHashAdd(a, RollingHashMod-b)
+}
+
+// rollingHashPow は、base^nをRollingHashModで割ったあまりを返します.
+func rollingHashPow(base uint64, n int) uint64 {
+	res := uint64(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			res = rollingHashMul(res, base)
+		}
+		base = rollingHashMul(base, base)
+	}
+	return res
+}
+
+// rollingHashPows は、base^0からbase^nまでを格納したsliceを返します.
+func rollingHashPows(base uint64, n int) []uint64 {
+	pow := make([]uint64, n+1)
+	pow[0] = 1
+	for i := 0; i < n; i++ {
+		pow[i+1] = rollingHashMul(pow[i], base)
+	}
+	return pow
+}
+
+// rollingHashValue は、列の要素vをハッシュ計算用の値に変換します.
+// 負の値をそのままuint64に変換すると2^64を法とした値になり、別の値と衝突するため、符号付きのまま剰余を求めます.
+func rollingHashValue(v int) uint64 {
+	x := v % RollingHashMod
+	if x < 0 {
+		x += RollingHashMod
+	}
+	return uint64(x)
+}
+
+var rollingHashRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// NewRollingHashBase は、ローリングハッシュの基数として利用する、実行ごとに異なるランダムな値を返します.
+// ハッシュ値を比較する全てのRollingHashは、同じ基数で構築する必要があります.
+func NewRollingHashBase() uint64 {
+	return uint64(rollingHashRand.Int63n(RollingHashMod-1<<20)) + 1<<20
+}
+
+// RollingHash は、列の任意の連続部分列のハッシュ値をO(1)で返すローリングハッシュです.
+// 部分列s[l:r]のハッシュ値は、Σ s[i]*base^(r-1-i) mod 2^61-1です.
+type RollingHash struct {
+	base   uint64
+	prefix []uint64
+	pow    []uint64
+}
+
+// NewRollingHash は、sに対して基数baseのローリングハッシュを構築します. 計算量はO(N)です.
+// sの要素は負の値でも構いませんが、2^61-1を法として等しい値は同じ値として扱われます.
+func NewRollingHash(s []int, base uint64) *RollingHash {
+	prefix := make([]uint64, len(s)+1)
+	for i, v := range s {
+		prefix[i+1] = rollingHashAdd(rollingHashMul(prefix[i], base), rollingHashValue(v))
+	}
+	return &RollingHash{base: base, prefix: prefix, pow: rollingHashPows(base, len(s))}
+}
+
+// NewRollingHashString は、文字列sをバイト列とみなしてローリングハッシュを構築します.
+func NewRollingHashString(s string, base uint64) *RollingHash {
+	return NewRollingHash(stringToInts(s), base)
+}
+
+// Len は、元の列の長さを返します.
+func (h *RollingHash) Len() int {
+	return len(h.prefix) - 1
+}
+
+// Hash は、半開区間[l, r)の部分列のハッシュ値を返します.
+func (h *RollingHash) Hash(l, r int) uint64 {
+	return rollingHashSub(h.prefix[r], rollingHashMul(h.prefix[l], h.pow[r-l]))
+}
+
+// Concat は、ハッシュ値がh1の列の後ろに、ハッシュ値がh2で長さがlen2の列を連結した列のハッシュ値を返します.
+func (h *RollingHash) Concat(h1, h2 uint64, len2 int) uint64 {
+	var pow uint64
+	if len2 < len(h.pow) {
+		pow = h.pow[len2]
+	} else {
+		pow = rollingHashPow(h.base, len2)
+	}
+	return rollingHashAdd(rollingHashMul(h1, pow), h2)
+}
+
+// LCP は、hの元の列のi番目以降と、otherの元の列のj番目以降の最長共通接頭辞の長さを返します.
+// otherにはh自身を渡すこともできます. hとotherは同じ基数で構築されている必要があります. 計算量はO(log N)です.
+func (h *RollingHash) LCP(other *RollingHash, i, j int) int {
+	maxLen := MustMinInt(h.Len()-i, other.Len()-j)
+	return BinarySearchInt(0, maxLen+1, func(k int) bool {
+		return h.Hash(i, i+k) == other.Hash(j, j+k)
+	})
+}
+
+// RollingHash2D は、二次元の列の任意の長方形領域のハッシュ値をO(1)で返すローリングハッシュです.
+type RollingHash2D struct {
+	prefix     [][]uint64
+	pow1, pow2 []uint64
+}
+
+// NewRollingHash2D は、gridに対して、行方向の基数base1、列方向の基数base2のローリングハッシュを構築します.
+// 各行の長さが異なる場合はエラーを返します. 計算量はO(HW)です.
+func NewRollingHash2D(grid [][]int, base1, base2 uint64) (*RollingHash2D, error) {
+	w := 0
+	if len(grid) > 0 {
+		w = len(grid[0])
+	}
+	prefix := make([][]uint64, len(grid)+1)
+	prefix[0] = make([]uint64, w+1)
+	for i, line := range grid {
+		if len(line) != w {
+			return nil, fmt.Errorf("%dth line length(%d) is different from first line length(%d)", i, len(line), w)
+		}
+		prefix[i+1] = make([]uint64, w+1)
+		// まず各行で列方向のハッシュを計算し、それを行方向に積み上げる
+		rowHash := uint64(0)
+		for j, v := range line {
+			rowHash = rollingHashAdd(rollingHashMul(rowHash, base2), rollingHashValue(v))
+			prefix[i+1][j+1] = rollingHashAdd(rollingHashMul(prefix[i][j+1], base1), rowHash)
+		}
+	}
+	return &RollingHash2D{
+		prefix: prefix,
+		pow1:   rollingHashPows(base1, len(grid)),
+		pow2:   rollingHashPows(base2, w),
+	}, nil
+}
+
+// NewRollingHash2DString は、各文字列をバイト列とみなしてNewRollingHash2Dを返します.
+func NewRollingHash2DString(grid []string, base1, base2 uint64) (*RollingHash2D, error) {
+	g := make([][]int, len(grid))
+	for i, line := range grid {
+		g[i] = stringToInts(line)
+	}
+	return NewRollingHash2D(g, base1, base2)
+}
+
+// Hash は、[r1, r2)行[c1, c2)列の長方形領域のハッシュ値を返します.
+func (h *RollingHash2D) Hash(r1, c1, r2, c2 int) uint64 {
+	p1, p2 := h.pow1[r2-r1], h.pow2[c2-c1]
+	res := rollingHashSub(h.prefix[r2][c2], rollingHashMul(h.prefix[r1][c2], p1))
+	res = rollingHashSub(res, rollingHashMul(h.prefix[r2][c1], p2))
+	return rollingHashAdd(res, rollingHashMul(rollingHashMul(h.prefix[r1][c1], p1), p2))
+}
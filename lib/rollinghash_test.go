@@ -0,0 +1,143 @@
+package lib
+
+import "testing"
+
+func Test_rollingHashMul(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b uint64
+		want uint64
+	}{
+		{name: "0", a: 0, b: 0, want: 0},
+		{name: "小さな値", a: 12345, b: 67890, want: 838102050},
+		{name: "法-1の2乗", a: RollingHashMod - 1, b: RollingHashMod - 1, want: 1},
+		{name: "法-1の2倍", a: RollingHashMod - 1, b: 2, want: RollingHashMod - 2},
+		{name: "積がちょうど2^61", a: 1 << 31, b: 1 << 30, want: 1},
+		{name: "積が2^120", a: 1 << 60, b: 1 << 60, want: 1 << 59},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rollingHashMul(tt.a, tt.b); got != tt.want {
+				t.Errorf("rollingHashMul(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollingHash(t *testing.T) {
+	base := NewRollingHashBase()
+	s := "abracadabra"
+	h := NewRollingHashString(s, base)
+	hashTests := []struct {
+		name      string
+		l1, r1    int
+		l2, r2    int
+		wantEqual bool
+	}{
+		{name: "同じ部分文字列", l1: 0, r1: 4, l2: 7, r2: 11, wantEqual: true},
+		{name: "1文字", l1: 0, r1: 1, l2: 10, r2: 11, wantEqual: true},
+		{name: "空文字列", l1: 0, r1: 0, l2: 5, r2: 5, wantEqual: true},
+		{name: "異なる部分文字列", l1: 0, r1: 3, l2: 3, r2: 6, wantEqual: false},
+		{name: "離れた位置の同じ部分文字列", l1: 1, r1: 3, l2: 8, r2: 10, wantEqual: true},
+		{name: "同じ文字からなるが順序が異なる", l1: 3, r1: 5, l2: 4, r2: 6, wantEqual: false},
+	}
+	for _, tt := range hashTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := h.Hash(tt.l1, tt.r1) == h.Hash(tt.l2, tt.r2); got != tt.wantEqual {
+				t.Errorf("Hash(%d, %d) == Hash(%d, %d) is %v, want %v", tt.l1, tt.r1, tt.l2, tt.r2, got, tt.wantEqual)
+			}
+		})
+	}
+
+	// t2 = "cadabra" + "ab"
+	t2 := s[4:] + s[:2]
+	other := NewRollingHashString(t2, base)
+	lcpTests := []struct {
+		name string
+		h1   *RollingHash
+		h2   *RollingHash
+		i, j int
+		want int
+	}{
+		{name: "末尾まで一致", h1: h, h2: h, i: 0, j: 7, want: 4},
+		{name: "1文字目のみ一致", h1: h, h2: h, i: 0, j: 3, want: 1},
+		{name: "同じ位置", h1: h, h2: h, i: 2, j: 2, want: 9},
+		{name: "一致しない", h1: h, h2: h, i: 0, j: 1, want: 0},
+		{name: "異なる列の間", h1: other, h2: h, i: 0, j: 4, want: 7},
+		{name: "異なる列の末尾", h1: other, h2: h, i: 7, j: 0, want: 2},
+	}
+	for _, tt := range lcpTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h1.LCP(tt.h2, tt.i, tt.j); got != tt.want {
+				t.Errorf("LCP(%d, %d) = %d, want %d", tt.i, tt.j, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := other.Hash(0, 7), h.Hash(4, 11); got != want {
+		t.Errorf("Hash of same substring is different: %d, %d", got, want)
+	}
+	if got, want := h.Concat(h.Hash(4, 11), h.Hash(0, 2), 2), other.Hash(0, 9); got != want {
+		t.Errorf("Concat() = %d, want %d", got, want)
+	}
+	if got, want := other.Concat(other.Hash(0, 0), h.Hash(0, 11), 11), h.Hash(0, 11); got != want {
+		t.Errorf("Concat() with long right part = %d, want %d", got, want)
+	}
+}
+
+func TestNewRollingHash(t *testing.T) {
+	base := NewRollingHashBase()
+	tests := []struct {
+		name      string
+		a, b      []int
+		wantEqual bool
+	}{
+		// 2^64 ≡ 8 (mod 2^61-1)のため、uint64に変換してから剰余を求めると-1と7が衝突する
+		{name: "-1と7", a: []int{-1, 2}, b: []int{7, 2}, wantEqual: false},
+		{name: "-8と0", a: []int{-8, 2}, b: []int{0, 2}, wantEqual: false},
+		{name: "-3と5", a: []int{-3}, b: []int{5}, wantEqual: false},
+		{name: "2^61-1を法として等しい値", a: []int{-1, 2}, b: []int{RollingHashMod - 1, 2}, wantEqual: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ha, hb := NewRollingHash(tt.a, base), NewRollingHash(tt.b, base)
+			if got := ha.Hash(0, ha.Len()) == hb.Hash(0, hb.Len()); got != tt.wantEqual {
+				t.Errorf("hash of %v == hash of %v is %v, want %v", tt.a, tt.b, got, tt.wantEqual)
+			}
+		})
+	}
+}
+
+func TestRollingHash2D(t *testing.T) {
+	grid := []string{
+		"abab",
+		"baba",
+		"abab",
+	}
+	h := MustNewRollingHash2DString(grid, NewRollingHashBase(), NewRollingHashBase())
+	tests := []struct {
+		name           string
+		r1, c1, r2, c2 int
+		dh, dw         int
+		wantEqual      bool
+	}{
+		{name: "ずらした同じ正方形", r1: 0, c1: 0, r2: 1, c2: 1, dh: 2, dw: 2, wantEqual: true},
+		{name: "異なる正方形", r1: 0, c1: 0, r2: 0, c2: 1, dh: 2, dw: 2, wantEqual: false},
+		{name: "同じ行", r1: 0, c1: 0, r2: 2, c2: 0, dh: 1, dw: 4, wantEqual: true},
+		{name: "異なる行", r1: 0, c1: 0, r2: 1, c2: 0, dh: 1, dw: 4, wantEqual: false},
+		{name: "同じ縦長の長方形", r1: 0, c1: 0, r2: 0, c2: 2, dh: 3, dw: 2, wantEqual: true},
+		{name: "行の順序が異なる", r1: 0, c1: 0, r2: 1, c2: 0, dh: 2, dw: 1, wantEqual: false},
+		{name: "列の順序が異なる", r1: 0, c1: 0, r2: 0, c2: 1, dh: 1, dw: 2, wantEqual: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := h.Hash(tt.r1, tt.c1, tt.r1+tt.dh, tt.c1+tt.dw) == h.Hash(tt.r2, tt.c2, tt.r2+tt.dh, tt.c2+tt.dw)
+			if got != tt.wantEqual {
+				t.Errorf("(%d, %d) and (%d, %d) with size (%d, %d): got %v, want %v", tt.r1, tt.c1, tt.r2, tt.c2, tt.dh, tt.dw, got, tt.wantEqual)
+			}
+		})
+	}
+	if _, err := NewRollingHash2DString([]string{"ab", "c"}, 2, 3); err == nil {
+		t.Errorf("NewRollingHash2DString does not return error for ragged grid")
+	}
+}
@@ -0,0 +1,22 @@
+package lib
+
+// TernarySearchMinAAA は、lo以上hi以下の整数xのうち、f(x)が最小となるxを返します. 最小となるxが複数ある場合は最も小さいxを返します.
+// fは最小値をとるまで狭義単調減少、その後単調増加である必要があります. 計算量はfの呼び出しがO(log(hi-lo))回です.
+// 実数の関数にはTernarySearchMinRealを利用してください.
+func TernarySearchMinAAA(lo, hi AAA, f func(AAA) AAA) AAA {
+	// lo-1はオーバーフローしうるため番兵に使わず、loを直接確かめる
+	if lo >= hi || f(lo) <= f(lo+1) {
+		return lo
+	}
+	// [lo, hi)のうちf(x) > f(x+1)となる最大のxの次が最小値をとる
+	return BinarySearchAAA(lo, hi, func(x AAA) bool { return f(x) > f(x+1) }) + 1
+}
+
+// TernarySearchMaxAAA は、lo以上hi以下の整数xのうち、f(x)が最大となるxを返します. 最大となるxが複数ある場合は最も小さいxを返します.
+// fは最大値をとるまで狭義単調増加、その後単調減少である必要があります.
+func TernarySearchMaxAAA(lo, hi AAA, f func(AAA) AAA) AAA {
+	if lo >= hi || f(lo) >= f(lo+1) {
+		return lo
+	}
+	return BinarySearchAAA(lo, hi, func(x AAA) bool { return f(x) < f(x+1) }) + 1
+}
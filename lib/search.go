@@ -0,0 +1,32 @@
+package lib
+
+import "sort"
+
+// BinarySearchAAA は、predがokでtrue、ngでfalseとなる単調な述語であるとき、predがtrueとなるngに最も近い値を返します.
+// okとngの大小は問いません. okやngに対してpredは呼び出されないため、範囲外の番兵を渡すことができます.
+// 整数型ではokとngの差が1になるまで、浮動小数点型では中点がokかngと一致するまで探索します.
+// okとngに型の最小値や最大値を渡してもオーバーフローしません.
+func BinarySearchAAA(ok, ng AAA, pred func(AAA) bool) AAA {
+	for {
+		// ok+ngやng-okはオーバーフローしうるため、それぞれを2で割ってから足し、整数型で切り捨てられた余りを補正する
+		mid := ok/2 + ng/2 + (ok-ok/2*2+ng-ng/2*2)/2
+		if mid == ok || mid == ng {
+			return ok
+		}
+		if pred(mid) {
+			ok = mid
+		} else {
+			ng = mid
+		}
+	}
+}
+
+// LowerBoundAAA は、昇順に並んだvaluesのうち、v以上の値が最初に現れるindexを返します. 存在しない場合はlen(values)を返します.
+func LowerBoundAAA(values []AAA, v AAA) int {
+	return sort.Search(len(values), func(i int) bool { return values[i] >= v })
+}
+
+// UpperBoundAAA は、昇順に並んだvaluesのうち、vより大きい値が最初に現れるindexを返します. 存在しない場合はlen(values)を返します.
+func UpperBoundAAA(values []AAA, v AAA) int {
+	return sort.Search(len(values), func(i int) bool { return values[i] > v })
+}
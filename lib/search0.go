@@ -0,0 +1,74 @@
+package lib
+
+// BinarySearchReal は、predがokでtrue、ngでfalseとなる単調な述語であるとき、predがtrueとなる境界をiter回の反復で求めます.
+// 反復ごとに区間が半分になるため、iterは100程度あれば十分です. 誤差の判定が難しい場合に、BinarySearchFloat64の代わりに利用できます.
+func BinarySearchReal(ok, ng float64, iter int, pred func(float64) bool) float64 {
+	for i := 0; i < iter; i++ {
+		mid := (ok + ng) / 2
+		if pred(mid) {
+			ok = mid
+		} else {
+			ng = mid
+		}
+	}
+	return ok
+}
+
+// TernarySearchMinReal は、[lo, hi]で下に凸な関数fが最小となるxを、iter回の反復による三分探索で求めます.
+// 反復ごとに区間が2/3になるため、iterは200程度あれば十分です.
+func TernarySearchMinReal(lo, hi float64, iter int, f func(float64) float64) float64 {
+	for i := 0; i < iter; i++ {
+		m1, m2 := lo+(hi-lo)/3, hi-(hi-lo)/3
+		if f(m1) <= f(m2) {
+			hi = m2
+		} else {
+			lo = m1
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// TernarySearchMaxReal は、[lo, hi]で上に凸な関数fが最大となるxを、iter回の反復による三分探索で求めます.
+func TernarySearchMaxReal(lo, hi float64, iter int, f func(float64) float64) float64 {
+	return TernarySearchMinReal(lo, hi, iter, func(x float64) float64 { return -f(x) })
+}
+
+// midInt64 は、loとhiの中点を、オーバーフローせずに切り捨てで返します.
+func midInt64(lo, hi int64) int64 {
+	return lo + int64(uint64(hi-lo)/2)
+}
+
+// FirstTrueInt64 は、lo以上hi以下の整数のうち、predがtrueとなる最小の値を返します. 存在しない場合はfalseを返します.
+// predはfalse, ..., false, true, ..., trueと単調である必要があります. 答えを二分探索する問題で利用でき、
+// loやhiにint64の最小値や最大値を渡してもオーバーフローしません.
+func FirstTrueInt64(lo, hi int64, pred func(int64) bool) (int64, bool) {
+	if lo > hi || !pred(hi) {
+		return 0, false
+	}
+	for lo < hi {
+		mid := midInt64(lo, hi)
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, true
+}
+
+// LastTrueInt64 は、lo以上hi以下の整数のうち、predがtrueとなる最大の値を返します. 存在しない場合はfalseを返します.
+// predはtrue, ..., true, false, ..., falseと単調である必要があります.
+func LastTrueInt64(lo, hi int64, pred func(int64) bool) (int64, bool) {
+	if lo > hi || !pred(lo) {
+		return 0, false
+	}
+	for lo < hi {
+		mid := midInt64(lo, hi) + 1
+		if pred(mid) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo, true
+}
@@ -0,0 +1,339 @@
+package lib
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBinarySearchInt(t *testing.T) {
+	type args struct {
+		ok   int
+		ng   int
+		pred func(int) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "BinarySearchInt",
+			args: args{ok: 0, ng: 100, pred: func(x int) bool { return x*x <= 50 }},
+			want: 7,
+		},
+		{
+			// okがngより大きい場合
+			name: "BinarySearchInt",
+			args: args{ok: 100, ng: -1, pred: func(x int) bool { return x*x >= 50 }},
+			want: 8,
+		},
+		{
+			name: "BinarySearchInt",
+			args: args{ok: -1, ng: 10, pred: func(x int) bool { return false }},
+			want: -1,
+		},
+		{
+			name: "BinarySearchInt",
+			args: args{ok: 0, ng: math.MaxInt64, pred: func(x int) bool { return x <= 1000000000000000000 }},
+			want: 1000000000000000000,
+		},
+		{
+			// ng-okがオーバーフローする番兵
+			name: "BinarySearchInt",
+			args: args{ok: -1, ng: math.MaxInt64, pred: func(x int) bool { return x <= math.MaxInt64-1 }},
+			want: math.MaxInt64 - 1,
+		},
+		{
+			name: "BinarySearchInt",
+			args: args{ok: math.MaxInt64, ng: math.MinInt64, pred: func(x int) bool { return x >= -3 }},
+			want: -3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BinarySearchInt(tt.args.ok, tt.args.ng, tt.args.pred); got != tt.want {
+				t.Errorf("BinarySearchInt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinarySearchInt8(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   int8
+		ng   int8
+		pred func(int8) bool
+		want int8
+	}{
+		{
+			name: "BinarySearchInt8",
+			ok:   -1,
+			ng:   127,
+			pred: func(x int8) bool { return x <= 100 },
+			want: 100,
+		},
+		{
+			name: "BinarySearchInt8",
+			ok:   -1,
+			ng:   127,
+			pred: func(x int8) bool { return true },
+			want: 126,
+		},
+		{
+			name: "BinarySearchInt8",
+			ok:   math.MaxInt8,
+			ng:   math.MinInt8,
+			pred: func(x int8) bool { return x >= -127 },
+			want: -127,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BinarySearchInt8(tt.ok, tt.ng, tt.pred); got != tt.want {
+				t.Errorf("BinarySearchInt8() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinarySearchInt64(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   int64
+		ng   int64
+		pred func(int64) bool
+		want int64
+	}{
+		{
+			name: "BinarySearchInt64",
+			ok:   -1,
+			ng:   math.MaxInt64,
+			pred: func(x int64) bool { return x <= 1<<62 },
+			want: 1 << 62,
+		},
+		{
+			name: "BinarySearchInt64",
+			ok:   math.MinInt64,
+			ng:   math.MaxInt64,
+			pred: func(x int64) bool { return x < 0 },
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BinarySearchInt64(tt.ok, tt.ng, tt.pred); got != tt.want {
+				t.Errorf("BinarySearchInt64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinarySearchFloat64(t *testing.T) {
+	got := BinarySearchFloat64(0, 2, func(x float64) bool { return x*x <= 2 })
+	if math.Abs(got-math.Sqrt2) > 1e-12 {
+		t.Errorf("BinarySearchFloat64() = %v, want %v", got, math.Sqrt2)
+	}
+	got = BinarySearchReal(0, 2, 100, func(x float64) bool { return x*x <= 2 })
+	if math.Abs(got-math.Sqrt2) > 1e-12 {
+		t.Errorf("BinarySearchReal() = %v, want %v", got, math.Sqrt2)
+	}
+}
+
+func TestLowerBoundInt(t *testing.T) {
+	values := []int{1, 3, 3, 5}
+	tests := []struct {
+		name      string
+		v         int
+		wantLower int
+		wantUpper int
+	}{
+		{name: "LowerBoundInt", v: 0, wantLower: 0, wantUpper: 0},
+		{name: "LowerBoundInt", v: 3, wantLower: 1, wantUpper: 3},
+		{name: "LowerBoundInt", v: 4, wantLower: 3, wantUpper: 3},
+		{name: "LowerBoundInt", v: 5, wantLower: 3, wantUpper: 4},
+		{name: "LowerBoundInt", v: 6, wantLower: 4, wantUpper: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LowerBoundInt(values, tt.v); got != tt.wantLower {
+				t.Errorf("LowerBoundInt() = %v, want %v", got, tt.wantLower)
+			}
+			if got := UpperBoundInt(values, tt.v); got != tt.wantUpper {
+				t.Errorf("UpperBoundInt() = %v, want %v", got, tt.wantUpper)
+			}
+		})
+	}
+}
+
+func TestTernarySearchMinInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		lo      int
+		hi      int
+		f       func(int) int
+		wantMin int
+	}{
+		{
+			name:    "TernarySearchMinInt",
+			lo:      -100,
+			hi:      100,
+			f:       func(x int) int { return (x - 7) * (x - 7) },
+			wantMin: 7,
+		},
+		{
+			// 単調増加の場合は左端
+			name:    "TernarySearchMinInt",
+			lo:      3,
+			hi:      10,
+			f:       func(x int) int { return x },
+			wantMin: 3,
+		},
+		{
+			// 最小値が複数ある場合は最も左
+			name:    "TernarySearchMinInt",
+			lo:      0,
+			hi:      10,
+			f:       func(x int) int { return MustMaxInt(AbsInt(x-5)-2, 0) },
+			wantMin: 3,
+		},
+		{
+			name:    "TernarySearchMinInt",
+			lo:      5,
+			hi:      5,
+			f:       func(x int) int { return x },
+			wantMin: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TernarySearchMinInt(tt.lo, tt.hi, tt.f); got != tt.wantMin {
+				t.Errorf("TernarySearchMinInt() = %v, want %v", got, tt.wantMin)
+			}
+			neg := func(x int) int { return -tt.f(x) }
+			if got := TernarySearchMaxInt(tt.lo, tt.hi, neg); got != tt.wantMin {
+				t.Errorf("TernarySearchMaxInt() = %v, want %v", got, tt.wantMin)
+			}
+		})
+	}
+}
+
+func TestTernarySearchInt8(t *testing.T) {
+	// loやhiに型の最小値や最大値を渡してもオーバーフローしない
+	tests := []struct {
+		name   string
+		search func(lo, hi int8, f func(int8) int8) int8
+		lo     int8
+		hi     int8
+		f      func(int8) int8
+		want   int8
+	}{
+		{
+			name:   "TernarySearchMinInt8",
+			search: TernarySearchMinInt8,
+			lo:     math.MinInt8,
+			hi:     math.MaxInt8,
+			f:      func(x int8) int8 { return x },
+			want:   math.MinInt8,
+		},
+		{
+			name:   "TernarySearchMinInt8",
+			search: TernarySearchMinInt8,
+			lo:     math.MinInt8,
+			hi:     math.MaxInt8,
+			f:      func(x int8) int8 { return int8(AbsInt(int(x)-100) - 128) },
+			want:   100,
+		},
+		{
+			name:   "TernarySearchMaxInt8",
+			search: TernarySearchMaxInt8,
+			lo:     math.MinInt8,
+			hi:     math.MaxInt8,
+			f:      func(x int8) int8 { return x },
+			want:   math.MaxInt8,
+		},
+		{
+			name:   "TernarySearchMaxInt8",
+			search: TernarySearchMaxInt8,
+			lo:     math.MinInt8,
+			hi:     math.MaxInt8,
+			f:      func(x int8) int8 { return int8(-1 - int(x)) },
+			want:   math.MinInt8,
+		},
+		{
+			name:   "TernarySearchMaxInt8",
+			search: TernarySearchMaxInt8,
+			lo:     math.MinInt8,
+			hi:     math.MaxInt8,
+			f:      func(x int8) int8 { return int8(127 - AbsInt(int(x)+100)) },
+			want:   -100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.search(tt.lo, tt.hi, tt.f); got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTernarySearchMinReal(t *testing.T) {
+	got := TernarySearchMinReal(-10, 10, 200, func(x float64) float64 { return (x - 1.5) * (x - 1.5) })
+	if math.Abs(got-1.5) > 1e-6 {
+		t.Errorf("TernarySearchMinReal() = %v, want %v", got, 1.5)
+	}
+	got = TernarySearchMaxReal(0, math.Pi, 200, math.Sin)
+	if math.Abs(got-math.Pi/2) > 1e-6 {
+		t.Errorf("TernarySearchMaxReal() = %v, want %v", got, math.Pi/2)
+	}
+}
+
+func TestFirstTrueInt64(t *testing.T) {
+	tests := []struct {
+		name      string
+		lo        int64
+		hi        int64
+		pred      func(int64) bool
+		wantFirst int64
+		wantOk    bool
+	}{
+		{
+			name:      "FirstTrueInt64",
+			lo:        0,
+			hi:        100,
+			pred:      func(x int64) bool { return x*x >= 50 },
+			wantFirst: 8,
+			wantOk:    true,
+		},
+		{
+			name:      "FirstTrueInt64",
+			lo:        math.MinInt64,
+			hi:        math.MaxInt64,
+			pred:      func(x int64) bool { return x >= -5 },
+			wantFirst: -5,
+			wantOk:    true,
+		},
+		{
+			name:   "FirstTrueInt64",
+			lo:     0,
+			hi:     100,
+			pred:   func(x int64) bool { return false },
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FirstTrueInt64(tt.lo, tt.hi, tt.pred)
+			if ok != tt.wantOk || got != tt.wantFirst {
+				t.Errorf("FirstTrueInt64() = (%v, %v), want (%v, %v)", got, ok, tt.wantFirst, tt.wantOk)
+			}
+			// FirstTrueInt64で求めた境界の1つ手前が、否定した述語でのLastTrueInt64になる
+			got, ok = LastTrueInt64(tt.lo, tt.hi, func(x int64) bool { return !tt.pred(x) })
+			wantOk := !tt.wantOk || tt.wantFirst > tt.lo
+			if ok != wantOk || (tt.wantOk && ok && got != tt.wantFirst-1) || (!tt.wantOk && got != tt.hi) {
+				t.Errorf("LastTrueInt64() = (%v, %v)", got, ok)
+			}
+		})
+	}
+}
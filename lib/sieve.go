@@ -0,0 +1,203 @@
+package lib
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Sieve は、線形篩により求めたn以下の各整数の最小素因数を保持します.
+// 多数の整数を素因数分解する場合、PrimeFactorsIntのような試し割りよりも高速です.
+type Sieve struct {
+	n int
+	// Primes は、n以下の素数の昇順のsliceです.
+	Primes []int
+	// SmallestPrimeFactors は、各整数の最小素因数です. 0と1は0になります.
+	SmallestPrimeFactors []int
+}
+
+// NewSieve は、n以下の整数に対する篩を返します. 計算量はO(n)です.
+func NewSieve(n int) (*Sieve, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("negative n is given: %d", n)
+	}
+	spf := make([]int, n+1)
+	var primes []int
+	for i := 2; i <= n; i++ {
+		if spf[i] == 0 {
+			spf[i] = i
+			primes = append(primes, i)
+		}
+		for _, p := range primes {
+			if p > spf[i] || i*p > n {
+				break
+			}
+			spf[i*p] = p
+		}
+	}
+	return &Sieve{n: n, Primes: primes, SmallestPrimeFactors: spf}, nil
+}
+
+func (s *Sieve) validate(x int) error {
+	if x < 1 || x > s.n {
+		return fmt.Errorf("x(%d) is out of range [1, %d]", x, s.n)
+	}
+	return nil
+}
+
+// IsPrime は、xが素数であるかをO(1)で返します. xは篩の範囲内である必要があります.
+func (s *Sieve) IsPrime(x int) bool {
+	return x >= 2 && s.SmallestPrimeFactors[x] == x
+}
+
+// PrimeFactors は、xを素因数分解した結果を昇順で返します(例: 12 -> [2 2 3]). 計算量はO(log x)です.
+func (s *Sieve) PrimeFactors(x int) ([]int, error) {
+	if err := s.validate(x); err != nil {
+		return nil, err
+	}
+	var pfs []int
+	for x > 1 {
+		p := s.SmallestPrimeFactors[x]
+		pfs = append(pfs, p)
+		x /= p
+	}
+	return pfs, nil
+}
+
+// PrimeFactorCounts は、xを素因数分解した結果を{素数, 指数}の組として素数の昇順で返します(例: 12 -> [[2 2] [3 1]]).
+func (s *Sieve) PrimeFactorCounts(x int) ([][2]int, error) {
+	pfs, err := s.PrimeFactors(x)
+	if err != nil {
+		return nil, err
+	}
+	return PrimeFactorsToCounts(pfs), nil
+}
+
+// Divisors は、xの約数を昇順で返します.
+func (s *Sieve) Divisors(x int) ([]int, error) {
+	pfs, err := s.PrimeFactors(x)
+	if err != nil {
+		return nil, err
+	}
+	return DivisorsFromPrimeFactors(pfs), nil
+}
+
+// PrimeFactorsToCounts は、昇順に並んだ素因数のsliceを{素数, 指数}の組に変換します.
+// PrimeFactorsIntの結果にも利用できます.
+func PrimeFactorsToCounts(pfs []int) (counts [][2]int) {
+	for _, p := range pfs {
+		if len(counts) > 0 && counts[len(counts)-1][0] == p {
+			counts[len(counts)-1][1]++
+			continue
+		}
+		counts = append(counts, [2]int{p, 1})
+	}
+	return
+}
+
+// DivisorsFromPrimeFactors は、昇順に並んだ素因数のsliceから約数を列挙し、昇順で返します.
+// PrimeFactorsIntの結果にも利用できます. 計算量は約数の個数をdとしてO(d log d)です.
+func DivisorsFromPrimeFactors(pfs []int) []int {
+	divisors := []int{1}
+	for _, pc := range PrimeFactorsToCounts(pfs) {
+		size := len(divisors)
+		pk := 1
+		for i := 0; i < pc[1]; i++ {
+			pk *= pc[0]
+			for _, d := range divisors[:size] {
+				divisors = append(divisors, d*pk)
+			}
+		}
+	}
+	sort.Ints(divisors)
+	return divisors
+}
+
+// TotientTable は、0からnまでの各整数についてのオイラーのφ関数の値を返します. 計算量はO(n log log n)です.
+func TotientTable(n int) []int {
+	phi := make([]int, n+1)
+	for i := range phi {
+		phi[i] = i
+	}
+	for p := 2; p <= n; p++ {
+		if phi[p] != p {
+			continue
+		}
+		for i := p; i <= n; i += p {
+			phi[i] -= phi[i] / p
+		}
+	}
+	return phi
+}
+
+// MobiusTable は、0からnまでの各整数についてのメビウス関数の値を返します. 0の値は0です. 計算量はO(n log log n)です.
+func MobiusTable(n int) []int {
+	mu := make([]int, n+1)
+	if n >= 1 {
+		mu[1] = 1
+	}
+	isComposite := make([]bool, n+1)
+	for i := 2; i <= n; i++ {
+		mu[i] = 1
+	}
+	for p := 2; p <= n; p++ {
+		if isComposite[p] {
+			continue
+		}
+		for i := p; i <= n; i += p {
+			if i > p {
+				isComposite[i] = true
+			}
+			mu[i] = -mu[i]
+		}
+		for i := p * p; i <= n; i += p * p {
+			mu[i] = 0
+		}
+	}
+	return mu
+}
+
+// SegmentedSieve は、[l, r)に含まれる素数を昇順で返します.
+// √rまでの素数で区間を篩うため、lが10^12程度でもr-lが10^6程度であれば高速に動作します.
+func SegmentedSieve(l, r int) ([]int, error) {
+	if l < 0 || r < l {
+		return nil, fmt.Errorf("invalid range [%d, %d)", l, r)
+	}
+	if l < 2 {
+		l = 2
+	}
+	if r <= l {
+		return nil, nil
+	}
+
+	sqrtR := int(math.Sqrt(float64(r)))
+	for sqrtR*sqrtR > r {
+		sqrtR--
+	}
+	for (sqrtR+1)*(sqrtR+1) <= r {
+		sqrtR++
+	}
+	small, err := NewSieve(sqrtR)
+	if err != nil {
+		return nil, err
+	}
+
+	isComposite := make([]bool, r-l)
+	for _, p := range small.Primes {
+		start := (l + p - 1) / p * p
+		if start < p*p {
+			start = p * p
+		}
+		for m := start; m < r; m += p {
+			isComposite[m-l] = true
+		}
+	}
+
+	var primes []int
+	for i, composite := range isComposite {
+		if !composite {
+			primes = append(primes, l+i)
+		}
+	}
+	return primes, nil
+}
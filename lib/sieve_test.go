@@ -0,0 +1,203 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewSieve(t *testing.T) {
+	tests := []struct {
+		name       string
+		n          int
+		wantPrimes []int
+		wantErr    bool
+	}{
+		{
+			name:       "NewSieve",
+			n:          30,
+			wantPrimes: []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29},
+		},
+		{
+			name:       "NewSieve",
+			n:          1,
+			wantPrimes: nil,
+		},
+		{
+			name:    "NewSieve",
+			n:       -1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSieve(tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSieve() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Primes, tt.wantPrimes) {
+				t.Errorf("Sieve.Primes = %v, want %v", got.Primes, tt.wantPrimes)
+			}
+			for x := 0; x <= tt.n; x++ {
+				if got.IsPrime(x) != ContainsInt(tt.wantPrimes, x) {
+					t.Errorf("Sieve.IsPrime(%d) = %v", x, got.IsPrime(x))
+				}
+			}
+		})
+	}
+}
+
+func TestSieve_PrimeFactors(t *testing.T) {
+	tests := []struct {
+		name         string
+		x            int
+		wantPfs      []int
+		wantDivisors []int
+		wantErr      bool
+	}{
+		{name: "Sieve_PrimeFactors", x: 1, wantPfs: nil, wantDivisors: []int{1}},
+		{name: "Sieve_PrimeFactors", x: 12, wantPfs: []int{2, 2, 3}, wantDivisors: []int{1, 2, 3, 4, 6, 12}},
+		{name: "Sieve_PrimeFactors", x: 36, wantPfs: []int{2, 2, 3, 3}, wantDivisors: []int{1, 2, 3, 4, 6, 9, 12, 18, 36}},
+		{name: "Sieve_PrimeFactors", x: 1999, wantPfs: []int{1999}, wantDivisors: []int{1, 1999}},
+		{
+			// 篩の上限ちょうど
+			name:         "Sieve_PrimeFactors",
+			x:            2000,
+			wantPfs:      []int{2, 2, 2, 2, 5, 5, 5},
+			wantDivisors: []int{1, 2, 4, 5, 8, 10, 16, 20, 25, 40, 50, 80, 100, 125, 200, 250, 400, 500, 1000, 2000},
+		},
+		{name: "Sieve_PrimeFactors", x: 2001, wantErr: true},
+		{name: "Sieve_PrimeFactors", x: 0, wantErr: true},
+	}
+	s := MustNewSieve(2000)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.PrimeFactors(tt.x)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sieve.PrimeFactors() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.wantPfs) {
+				t.Errorf("Sieve.PrimeFactors() = %v, want %v", got, tt.wantPfs)
+			}
+			gotDivisors, err := s.Divisors(tt.x)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sieve.Divisors() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotDivisors, tt.wantDivisors) {
+				t.Errorf("Sieve.Divisors() = %v, want %v", gotDivisors, tt.wantDivisors)
+			}
+		})
+	}
+}
+
+func TestSieve_PrimeFactorCounts(t *testing.T) {
+	tests := []struct {
+		name string
+		x    int
+		want [][2]int
+	}{
+		{name: "Sieve_PrimeFactorCounts", x: 1, want: nil},
+		{name: "Sieve_PrimeFactorCounts", x: 12, want: [][2]int{{2, 2}, {3, 1}}},
+		{name: "Sieve_PrimeFactorCounts", x: 97, want: [][2]int{{97, 1}}},
+		{name: "Sieve_PrimeFactorCounts", x: 360, want: [][2]int{{2, 3}, {3, 2}, {5, 1}}},
+	}
+	s := MustNewSieve(1000)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.MustPrimeFactorCounts(tt.x); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Sieve.PrimeFactorCounts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTotientTable(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{
+			name: "TotientTable",
+			n:    12,
+			want: []int{0, 1, 1, 2, 2, 4, 2, 6, 4, 6, 4, 10, 4},
+		},
+		{
+			name: "TotientTable",
+			n:    0,
+			want: []int{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TotientTable(tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TotientTable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMobiusTable(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{
+			name: "MobiusTable",
+			n:    12,
+			want: []int{0, 1, -1, -1, 0, -1, 1, -1, 0, 0, 1, -1, 0},
+		},
+		{
+			name: "MobiusTable",
+			n:    0,
+			want: []int{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MobiusTable(tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MobiusTable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentedSieve(t *testing.T) {
+	tests := []struct {
+		name    string
+		l, r    int
+		want    []int
+		wantErr bool
+	}{
+		{name: "SegmentedSieve", l: 0, r: 30, want: []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}},
+		{name: "SegmentedSieve", l: 90, r: 130, want: []int{97, 101, 103, 107, 109, 113, 127}},
+		{name: "SegmentedSieve", l: 2, r: 3, want: []int{2}},
+		{name: "SegmentedSieve", l: 0, r: 2, want: nil},
+		{name: "SegmentedSieve", l: 5, r: 5, want: nil},
+		{
+			name: "SegmentedSieve",
+			l:    1000000000000,
+			r:    1000000000100,
+			want: []int{1000000000039, 1000000000061, 1000000000063, 1000000000091},
+		},
+		{name: "SegmentedSieve", l: 5, r: 4, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SegmentedSieve(tt.l, tt.r)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SegmentedSieve() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SegmentedSieve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package lib
+
+import (
+	"fmt"
+	"sort"
+)
+
+// CompressAAA は、valuesを座標圧縮した結果を返します.
+// compressed[i]はvalues[i]が何番目に小さい値か(0始まり、重複は同じ番号)で、sortedは重複を除いて昇順に並べた値です.
+// sorted[compressed[i]] == values[i]が成り立つため、sortedは圧縮後の番号から元の値への逆写像として利用できます.
+func CompressAAA(values []AAA) (compressed []int, sorted []AAA) {
+	sorted = append([]AAA{}, values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	sorted = UniqSortedAAA(sorted)
+	compressed = make([]int, len(values))
+	for i, v := range values {
+		compressed[i] = LowerBoundAAA(sorted, v)
+	}
+	return
+}
+
+// ArgSortAAA は、valuesを昇順に並べたときのindexの列を返します. 値が等しい要素は元の順序を保ちます.
+func ArgSortAAA(values []AAA) []int {
+	idx := make([]int, len(values))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return values[idx[i]] < values[idx[j]] })
+	return idx
+}
+
+// SortAAALinesBy は、Input.GetAAALinesなどで得られる行のsliceを、lessに従って安定ソートします. linesは変更されます.
+func SortAAALinesBy(lines [][]AAA, less func(a, b []AAA) bool) {
+	sort.SliceStable(lines, func(i, j int) bool { return less(lines[i], lines[j]) })
+}
+
+// SortAAALinesByColumns は、行のsliceをcolumnsで指定した列の値の昇順に安定ソートします. linesは変更されます.
+// columns[0]の列の値が等しい行はcolumns[1]の列の値で比較し、以降同様に比較します.
+func SortAAALinesByColumns(lines [][]AAA, columns ...int) {
+	SortAAALinesBy(lines, func(a, b []AAA) bool {
+		for _, c := range columns {
+			if a[c] != b[c] {
+				return a[c] < b[c]
+			}
+		}
+		return false
+	})
+}
+
+// SortAAAParallel は、keysを昇順に安定ソートし、others内の各sliceもkeysと同じ順に並べ替えます.
+// 長さがkeysと異なるsliceが含まれる場合はエラーを返します. 型の異なるsliceを並べ替える場合は、ArgSortAAAの結果をPermuteIntなどのPermute系の関数に渡してください.
+func SortAAAParallel(keys []AAA, others ...[]AAA) error {
+	for i, o := range others {
+		if len(o) != len(keys) {
+			return fmt.Errorf("%dth slice length(%d) is different from keys length(%d)", i, len(o), len(keys))
+		}
+	}
+	idx := ArgSortAAA(keys)
+	tmp := make([]AAA, len(keys))
+	for _, s := range append([][]AAA{keys}, others...) {
+		for i, j := range idx {
+			tmp[i] = s[j]
+		}
+		copy(s, tmp)
+	}
+	return nil
+}
+
+// UniqSortedAAA は、昇順に並んだvaluesから連続する重複を取り除いたsliceを返します. 計算量はO(N)です.
+// valuesの領域を再利用するため、valuesの内容は変更されます.
+func UniqSortedAAA(values []AAA) []AAA {
+	res := values[:0]
+	for i, v := range values {
+		if i == 0 || v != res[len(res)-1] {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// MergeSortedAAA は、昇順に並んだaとbをマージした昇順のsliceを返します. 計算量はO(len(a)+len(b))です.
+func MergeSortedAAA(a, b []AAA) []AAA {
+	res := make([]AAA, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if b[j] < a[i] {
+			res = append(res, b[j])
+			j++
+		} else {
+			res = append(res, a[i])
+			i++
+		}
+	}
+	res = append(res, a[i:]...)
+	return append(res, b[j:]...)
+}
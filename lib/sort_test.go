@@ -0,0 +1,125 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompressInt(t *testing.T) {
+	tests := []struct {
+		name           string
+		values         []int
+		wantCompressed []int
+		wantSorted     []int
+	}{
+		{
+			name:           "CompressInt",
+			values:         []int{100, 5, 100, -3, 42},
+			wantCompressed: []int{3, 1, 3, 0, 2},
+			wantSorted:     []int{-3, 5, 42, 100},
+		},
+		{
+			name:           "CompressInt",
+			values:         []int{},
+			wantCompressed: []int{},
+			wantSorted:     []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCompressed, gotSorted := CompressInt(tt.values)
+			if !reflect.DeepEqual(gotCompressed, tt.wantCompressed) {
+				t.Errorf("CompressInt() compressed = %v, want %v", gotCompressed, tt.wantCompressed)
+			}
+			if !reflect.DeepEqual(gotSorted, tt.wantSorted) {
+				t.Errorf("CompressInt() sorted = %v, want %v", gotSorted, tt.wantSorted)
+			}
+		})
+	}
+}
+
+func TestArgSortInt(t *testing.T) {
+	values := []int{3, 1, 2, 1}
+	want := []int{1, 3, 2, 0}
+	if got := ArgSortInt(values); !reflect.DeepEqual(got, want) {
+		t.Errorf("ArgSortInt() = %v, want %v", got, want)
+	}
+	names := []string{"c", "a1", "b", "a2"}
+	if got := PermuteString(names, want); !reflect.DeepEqual(got, []string{"a1", "a2", "b", "c"}) {
+		t.Errorf("PermuteString() = %v", got)
+	}
+}
+
+func TestSortIntLinesByColumns(t *testing.T) {
+	lines := [][]int{{2, 5, 0}, {1, 9, 1}, {2, 3, 2}, {1, 9, 3}, {0, 7, 4}}
+	SortIntLinesByColumns(lines, 0, 1)
+	want := [][]int{{0, 7, 4}, {1, 9, 1}, {1, 9, 3}, {2, 3, 2}, {2, 5, 0}}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("SortIntLinesByColumns() = %v, want %v", lines, want)
+	}
+
+	// 1列目の降順、同じ場合は0列目の昇順
+	SortIntLinesBy(lines, func(a, b []int) bool {
+		if a[1] != b[1] {
+			return a[1] > b[1]
+		}
+		return a[0] < b[0]
+	})
+	want = [][]int{{1, 9, 1}, {1, 9, 3}, {0, 7, 4}, {2, 5, 0}, {2, 3, 2}}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("SortIntLinesBy() = %v, want %v", lines, want)
+	}
+}
+
+func TestSortIntParallel(t *testing.T) {
+	keys := []int{30, 10, 20}
+	a := []int{3, 1, 2}
+	b := []int{-3, -1, -2}
+	if err := SortIntParallel(keys, a, b); err != nil {
+		t.Fatalf("SortIntParallel() error = %v", err)
+	}
+	if !reflect.DeepEqual(keys, []int{10, 20, 30}) || !reflect.DeepEqual(a, []int{1, 2, 3}) || !reflect.DeepEqual(b, []int{-1, -2, -3}) {
+		t.Errorf("SortIntParallel() = %v, %v, %v", keys, a, b)
+	}
+	if err := SortIntParallel(keys, []int{1}); err == nil {
+		t.Errorf("SortIntParallel() error = nil, want error")
+	}
+}
+
+func TestUniqSortedInt(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{name: "UniqSortedInt", values: []int{1, 1, 2, 3, 3, 3}, want: []int{1, 2, 3}},
+		{name: "UniqSortedInt", values: []int{5}, want: []int{5}},
+		{name: "UniqSortedInt", values: []int{}, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UniqSortedInt(tt.values); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UniqSortedInt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeSortedInt(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int
+		b    []int
+		want []int
+	}{
+		{name: "MergeSortedInt", a: []int{1, 4, 6}, b: []int{2, 4, 7, 8}, want: []int{1, 2, 4, 4, 6, 7, 8}},
+		{name: "MergeSortedInt", a: []int{}, b: []int{1}, want: []int{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeSortedInt(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeSortedInt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
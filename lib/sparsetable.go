@@ -0,0 +1,95 @@
+package lib
+
+import "math/bits"
+
+// SparseTableAAA は、変更されない配列に対して、冪等かつ結合的な演算(min, max, gcdなど)による区間の集約値をO(1)で返すSparse Tableです.
+// 構築の計算量はO(N log N)です.
+type SparseTableAAA struct {
+	table [][]AAA
+	op    func(a, b AAA) AAA
+}
+
+// NewSparseTableAAA は、valuesに対して演算opによるSparse Tableを構築します.
+// opは結合的かつ冪等(op(a, a) = a)である必要があります. 冪等でない演算にはDisjointSparseTableAAAを利用してください.
+func NewSparseTableAAA(values []AAA, op func(a, b AAA) AAA) *SparseTableAAA {
+	table := [][]AAA{append([]AAA{}, values...)}
+	for k := 1; 1<<k <= len(values); k++ {
+		prev, half := table[k-1], 1<<(k-1)
+		cur := make([]AAA, len(values)-1<<k+1)
+		for i := range cur {
+			cur[i] = op(prev[i], prev[i+half])
+		}
+		table = append(table, cur)
+	}
+	return &SparseTableAAA{table: table, op: op}
+}
+
+// NewMinSparseTableAAA は、valuesの区間最小値を返すSparse Tableを構築します.
+func NewMinSparseTableAAA(values []AAA) *SparseTableAAA {
+	return NewSparseTableAAA(values, func(a, b AAA) AAA {
+		if a < b {
+			return a
+		}
+		return b
+	})
+}
+
+// NewMaxSparseTableAAA は、valuesの区間最大値を返すSparse Tableを構築します.
+func NewMaxSparseTableAAA(values []AAA) *SparseTableAAA {
+	return NewSparseTableAAA(values, func(a, b AAA) AAA {
+		if a > b {
+			return a
+		}
+		return b
+	})
+}
+
+// Query は、半開区間[l, r)の要素をopで集約した値を返します. l < rである必要があります. 計算量はO(1)です.
+func (s *SparseTableAAA) Query(l, r int) AAA {
+	k := bits.Len(uint(r-l)) - 1
+	return s.op(s.table[k][l], s.table[k][r-1<<k])
+}
+
+// DisjointSparseTableAAA は、変更されない配列に対して、結合的な演算による区間の集約値をO(1)で返すDisjoint Sparse Tableです.
+// Sparse Tableと異なり、和や積など冪等でない演算にも利用できます. 構築の計算量はO(N log N)です.
+type DisjointSparseTableAAA struct {
+	table [][]AAA
+	op    func(a, b AAA) AAA
+}
+
+// NewDisjointSparseTableAAA は、valuesに対して結合的な演算opによるDisjoint Sparse Tableを構築します. opは可換である必要はありません.
+func NewDisjointSparseTableAAA(values []AAA, op func(a, b AAA) AAA) *DisjointSparseTableAAA {
+	n := len(values)
+	table := [][]AAA{append([]AAA{}, values...)}
+	// table[k]は、2^kの倍数の位置midを境界として、midより左側の要素には[i, mid)の集約値を、右側の要素には[mid, i]の集約値を持つ
+	for k := 1; 1<<k < n; k++ {
+		cur := make([]AAA, n)
+		h := 1 << k
+		for mid := h; mid < n; mid += 2 * h {
+			cur[mid-1] = values[mid-1]
+			for i := mid - 2; i >= mid-h; i-- {
+				cur[i] = op(values[i], cur[i+1])
+			}
+			cur[mid] = values[mid]
+			for i := mid + 1; i < mid+h && i < n; i++ {
+				cur[i] = op(cur[i-1], values[i])
+			}
+		}
+		table = append(table, cur)
+	}
+	return &DisjointSparseTableAAA{table: table, op: op}
+}
+
+// Query は、半開区間[l, r)の要素を左から順にopで集約した値を返します. l < rである必要があります. 計算量はO(1)です.
+func (d *DisjointSparseTableAAA) Query(l, r int) AAA {
+	r--
+	if l == r {
+		return d.table[0][l]
+	}
+	// lとrが異なる最上位のビットが、両者を分ける境界の階層になる
+	k := bits.Len(uint(l^r)) - 1
+	if k == 0 {
+		return d.op(d.table[0][l], d.table[0][r])
+	}
+	return d.op(d.table[k][l], d.table[k][r])
+}
@@ -0,0 +1,35 @@
+package lib_test
+
+import (
+	"fmt"
+
+	"github.com/mpppk/atcoder-workspace/lib"
+)
+
+func ExampleNewMinSparseTableInt() {
+	table := lib.NewMinSparseTableInt([]int{5, 3, 8, 1, 9, 2})
+	fmt.Println(table.Query(0, 3), table.Query(2, 6), table.Query(4, 5))
+
+	// Output:
+	// 3 1 9
+}
+
+func ExampleNewSparseTableInt() {
+	table := lib.NewSparseTableInt([]int{12, 18, 24, 7, 14}, func(a, b int) int {
+		return lib.Gcd(a, b)
+	})
+	fmt.Println(table.Query(0, 3), table.Query(3, 5), table.Query(0, 5))
+
+	// Output:
+	// 6 7 1
+}
+
+func ExampleNewDisjointSparseTableInt() {
+	table := lib.NewDisjointSparseTableInt([]int{1, 2, 3, 4, 5}, func(a, b int) int {
+		return a + b
+	})
+	fmt.Println(table.Query(0, 5), table.Query(1, 4), table.Query(2, 3))
+
+	// Output:
+	// 15 9 3
+}
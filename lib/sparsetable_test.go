@@ -0,0 +1,95 @@
+package lib
+
+import "testing"
+
+func TestSparseTableAAA(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []AAA
+	}{
+		{name: "1要素", values: []AAA{7}},
+		{name: "2要素", values: []AAA{2, -3}},
+		{name: "長さが2の冪でない", values: []AAA{3, 1, 4, 1, 5, 9, 2, 6, 5, 3}},
+		{name: "長さが2の冪", values: []AAA{8, -1, 8, 2, 0, 0, 7, -5, 3, 3, 9, -9, 4, 6, 1, 2}},
+		{name: "単調増加", values: []AAA{-2, -1, 0, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minTable, maxTable := NewMinSparseTableAAA(tt.values), NewMaxSparseTableAAA(tt.values)
+			// 全ての区間について、区間の要素を直接調べた値と比較する
+			for l := 0; l < len(tt.values); l++ {
+				for r := l + 1; r <= len(tt.values); r++ {
+					if got, want := minTable.Query(l, r), MustMinAAA(tt.values[l:r]...); got != want {
+						t.Errorf("min Query(%d, %d) = %v, want %v", l, r, got, want)
+					}
+					if got, want := maxTable.Query(l, r), MustMaxAAA(tt.values[l:r]...); got != want {
+						t.Errorf("max Query(%d, %d) = %v, want %v", l, r, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSparseTableInt_Gcd(t *testing.T) {
+	values := []int{12, 18, 24, 7, 35, 49, 14, 0, 6, 9, 27}
+	table := NewSparseTableInt(values, func(a, b int) int { return gcd(a, b) })
+	tests := []struct {
+		name string
+		l, r int
+		want int
+	}{
+		{name: "1要素", l: 3, r: 4, want: 7},
+		{name: "共通の約数を持つ区間", l: 0, r: 3, want: 6},
+		{name: "互いに素な要素を含む区間", l: 0, r: 4, want: 1},
+		{name: "0を含む区間", l: 6, r: 9, want: 2},
+		{name: "全体", l: 0, r: 11, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := table.Query(tt.l, tt.r); got != tt.want {
+				t.Errorf("gcd Query(%d, %d) = %d, want %d", tt.l, tt.r, got, tt.want)
+			}
+		})
+	}
+	for l := 0; l < len(values); l++ {
+		want := values[l]
+		for r := l + 1; r <= len(values); r++ {
+			want = gcd(want, values[r-1])
+			if got := table.Query(l, r); got != want {
+				t.Errorf("gcd Query(%d, %d) = %d, want %d", l, r, got, want)
+			}
+		}
+	}
+}
+
+func TestDisjointSparseTableAAA(t *testing.T) {
+	// 非可換な演算として、10進数の桁を連結する演算を使う
+	concat := func(a, b AAA) AAA {
+		for d := b; d >= 1; d /= 10 {
+			a *= 10
+		}
+		return a + b
+	}
+	table := NewDisjointSparseTableAAA([]AAA{3, 1, 4, 1, 5, 9, 2, 6, 5, 3}, concat)
+	tests := []struct {
+		name string
+		l, r int
+		want AAA
+	}{
+		{name: "1要素", l: 0, r: 1, want: 3},
+		{name: "全体", l: 0, r: 10, want: 3141592653},
+		{name: "最下層の境界をまたぐ2要素", l: 2, r: 4, want: 41},
+		{name: "上の階層の境界をまたぐ2要素", l: 1, r: 3, want: 14},
+		{name: "長さが2の冪", l: 4, r: 8, want: 5926},
+		{name: "境界をまたぐ区間", l: 3, r: 9, want: 159265},
+		{name: "末尾を含む", l: 7, r: 10, want: 653},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := table.Query(tt.l, tt.r); got != tt.want {
+				t.Errorf("Query(%d, %d) = %v, want %v", tt.l, tt.r, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,16 @@
 package lib
 
+import (
+	"fmt"
+	"strings"
+)
+
+// LowerAlphabet は、英小文字を順に並べた文字列です.
+const LowerAlphabet = "abcdefghijklmnopqrstuvwxyz"
+
+// UpperAlphabet は、英大文字を順に並べた文字列です.
+const UpperAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
 // FindPosFromStringGrid は、stringの二次元Sliceから、与えられた文字列を持つ要素のindexを返します.
 func FindPosFromStringGrid(m [][]string, s string) (row int, col int) {
 	for rowIndex, row := range m {
@@ -11,3 +22,438 @@ func FindPosFromStringGrid(m [][]string, s string) (row int, col int) {
 	}
 	panic(s + " not found")
 }
+
+// stringToInts は、sの各バイトを要素とするsliceを返します.
+func stringToInts(s string) []int {
+	res := make([]int, len(s))
+	for i := 0; i < len(s); i++ {
+		res[i] = int(s[i])
+	}
+	return res
+}
+
+// ZArray は、各iについてsとs[i:]の最長共通接頭辞の長さを格納したsliceを返します. 計算量はO(N)です.
+func ZArray(s []int) []int {
+	n := len(s)
+	z := make([]int, n)
+	if n == 0 {
+		return z
+	}
+	z[0] = n
+	// [l, r)は、これまでに見つかった、sの接頭辞と一致する区間のうちrが最大のもの
+	for i, l, r := 1, 0, 0; i < n; i++ {
+		if i < r {
+			z[i] = MustMinInt(r-i, z[i-l])
+		}
+		for i+z[i] < n && s[z[i]] == s[i+z[i]] {
+			z[i]++
+		}
+		if i+z[i] > r {
+			l, r = i, i+z[i]
+		}
+	}
+	return z
+}
+
+// ZArrayString は、文字列sをバイト列とみなしてZArrayを返します.
+func ZArrayString(s string) []int {
+	return ZArray(stringToInts(s))
+}
+
+// PrefixFunction は、各iについてs[:i+1]の真の接頭辞かつ接尾辞である文字列の最大の長さを格納したsliceを返します.
+// KMP法の失敗関数として利用できます. 計算量はO(N)です.
+func PrefixFunction(s []int) []int {
+	pi := make([]int, len(s))
+	for i := 1; i < len(s); i++ {
+		k := pi[i-1]
+		for k > 0 && s[i] != s[k] {
+			k = pi[k-1]
+		}
+		if s[i] == s[k] {
+			k++
+		}
+		pi[i] = k
+	}
+	return pi
+}
+
+// PrefixFunctionString は、文字列sをバイト列とみなしてPrefixFunctionを返します.
+func PrefixFunctionString(s string) []int {
+	return PrefixFunction(stringToInts(s))
+}
+
+// KMPSearch は、textの中でpatternが出現する全ての位置を昇順に返します. 出現が重なっていても全て返します.
+// patternが空の場合は0からlen(text)までの全ての位置を返します. 計算量はO(len(text) + len(pattern))です.
+func KMPSearch(text, pattern []int) []int {
+	positions := []int{}
+	if len(pattern) == 0 {
+		for i := 0; i <= len(text); i++ {
+			positions = append(positions, i)
+		}
+		return positions
+	}
+	pi := PrefixFunction(pattern)
+	k := 0
+	for i, c := range text {
+		for k > 0 && c != pattern[k] {
+			k = pi[k-1]
+		}
+		if c == pattern[k] {
+			k++
+		}
+		if k == len(pattern) {
+			positions = append(positions, i-k+1)
+			k = pi[k-1]
+		}
+	}
+	return positions
+}
+
+// KMPSearchString は、文字列text中で文字列patternが出現する全ての位置(バイト単位)を昇順に返します.
+func KMPSearchString(text, pattern string) []int {
+	return KMPSearch(stringToInts(text), stringToInts(pattern))
+}
+
+// Manacher は、sの各位置を中心とする最長の回文の半径を返します. 計算量はO(N)です.
+// odd[i]は、s[i-k+1:i+k]が回文となる最大のk(回文の長さは2k-1)です.
+// even[i]は、s[i-k:i+k]が回文となる最大のk(回文の長さは2k)で、s[i-1]とs[i]の間を中心とする回文を表します.
+func Manacher(s []int) (odd, even []int) {
+	n := len(s)
+	// 要素の間に番兵-1を挟んだ長さ2n-1の列に対して、奇数長の回文の半径を求める
+	t := make([]int, 0, 2*n)
+	for i, v := range s {
+		if i > 0 {
+			t = append(t, -1)
+		}
+		t = append(t, v)
+	}
+	rad := make([]int, len(t))
+	for i, c := 0, 0; i < len(t); i++ {
+		if c+rad[c] > i {
+			rad[i] = MustMinInt(rad[2*c-i], c+rad[c]-i)
+		}
+		for i-rad[i] >= 0 && i+rad[i] < len(t) && t[i-rad[i]] == t[i+rad[i]] {
+			rad[i]++
+		}
+		if i+rad[i] > c+rad[c] {
+			c = i
+		}
+	}
+
+	odd, even = make([]int, n), make([]int, n)
+	for i := range t {
+		if i%2 == 0 {
+			odd[i/2] = (rad[i] + 1) / 2
+		} else {
+			even[i/2+1] = rad[i] / 2
+		}
+	}
+	return odd, even
+}
+
+// ManacherString は、文字列sをバイト列とみなしてManacherを返します.
+func ManacherString(s string) (odd, even []int) {
+	return Manacher(stringToInts(s))
+}
+
+// saIs は、全ての要素が0以上upper以下であるsの接尾辞配列を、SA-ISにより返します.
+func saIs(s []int, upper int) []int {
+	n := len(s)
+	switch n {
+	case 0:
+		return []int{}
+	case 1:
+		return []int{0}
+	case 2:
+		if s[0] < s[1] {
+			return []int{0, 1}
+		}
+		return []int{1, 0}
+	}
+
+	// ls[i]は、s[i:]がs[i+1:]より辞書順で小さい(S型)かどうか
+	ls := make([]bool, n)
+	for i := n - 2; i >= 0; i-- {
+		if s[i] == s[i+1] {
+			ls[i] = ls[i+1]
+		} else {
+			ls[i] = s[i] < s[i+1]
+		}
+	}
+	// sumL[c], sumS[c]は、値cのL型、S型のbucketの開始位置
+	sumL, sumS := make([]int, upper+2), make([]int, upper+2)
+	for i, v := range s {
+		if ls[i] {
+			sumL[v+1]++
+		} else {
+			sumS[v]++
+		}
+	}
+	for i := 0; i <= upper; i++ {
+		sumS[i] += sumL[i]
+		sumL[i+1] += sumS[i]
+	}
+
+	sa := make([]int, n)
+	buf := make([]int, upper+2)
+	induce := func(lms []int) {
+		for i := range sa {
+			sa[i] = -1
+		}
+		copy(buf, sumS)
+		for _, d := range lms {
+			sa[buf[s[d]]] = d
+			buf[s[d]]++
+		}
+		copy(buf, sumL)
+		sa[buf[s[n-1]]] = n - 1
+		buf[s[n-1]]++
+		for i := 0; i < n; i++ {
+			if v := sa[i]; v >= 1 && !ls[v-1] {
+				sa[buf[s[v-1]]] = v - 1
+				buf[s[v-1]]++
+			}
+		}
+		copy(buf, sumL)
+		for i := n - 1; i >= 0; i-- {
+			if v := sa[i]; v >= 1 && ls[v-1] {
+				buf[s[v-1]+1]--
+				sa[buf[s[v-1]+1]] = v - 1
+			}
+		}
+	}
+
+	// LMS(直前がL型であるS型の位置)を列挙する
+	lmsMap := make([]int, n+1)
+	var lms []int
+	for i := range lmsMap {
+		lmsMap[i] = -1
+	}
+	for i := 1; i < n; i++ {
+		if !ls[i-1] && ls[i] {
+			lmsMap[i] = len(lms)
+			lms = append(lms, i)
+		}
+	}
+	m := len(lms)
+	induce(lms)
+	if m == 0 {
+		return sa
+	}
+
+	// LMS部分文字列を番号付けして縮約した列の接尾辞配列を再帰的に求め、LMSの正しい順序を得る
+	sortedLms := make([]int, 0, m)
+	for _, v := range sa {
+		if lmsMap[v] != -1 {
+			sortedLms = append(sortedLms, v)
+		}
+	}
+	recS := make([]int, m)
+	recUpper := 0
+	recS[lmsMap[sortedLms[0]]] = 0
+	for i := 1; i < m; i++ {
+		l, r := sortedLms[i-1], sortedLms[i]
+		endL, endR := n, n
+		if lmsMap[l]+1 < m {
+			endL = lms[lmsMap[l]+1]
+		}
+		if lmsMap[r]+1 < m {
+			endR = lms[lmsMap[r]+1]
+		}
+		same := endL-l == endR-r
+		if same {
+			for l < endL && s[l] == s[r] {
+				l++
+				r++
+			}
+			if l == n || r == n || s[l] != s[r] {
+				same = false
+			}
+		}
+		if !same {
+			recUpper++
+		}
+		recS[lmsMap[sortedLms[i]]] = recUpper
+	}
+	recSA := saIs(recS, recUpper)
+	for i := range sortedLms {
+		sortedLms[i] = lms[recSA[i]]
+	}
+	induce(sortedLms)
+	return sa
+}
+
+// SuffixArray は、sの接尾辞配列を返します. すなわち、s[sa[0]:], s[sa[1]:], ...が辞書順に並ぶような0からN-1までの順列saを返します.
+// sの要素は任意の整数で構いません. 座標圧縮の後にSA-ISを行うため、計算量はO(N log N)です.
+func SuffixArray(s []int) []int {
+	compressed, sorted := CompressInt(s)
+	return saIs(compressed, MustMaxInt(len(sorted)-1, 0))
+}
+
+// SuffixArrayString は、文字列sをバイト列とみなして接尾辞配列を返します. 計算量はO(N)です.
+func SuffixArrayString(s string) []int {
+	return saIs(stringToInts(s), 255)
+}
+
+// LCPArray は、sとその接尾辞配列saから、lcp[i]がs[sa[i]:]とs[sa[i+1]:]の最長共通接頭辞の長さとなる長さN-1のsliceを返します.
+// Kasaiのアルゴリズムにより、計算量はO(N)です.
+func LCPArray(s, sa []int) []int {
+	n := len(s)
+	if n == 0 {
+		return []int{}
+	}
+	rank := make([]int, n)
+	for i, p := range sa {
+		rank[p] = i
+	}
+	lcp := make([]int, n-1)
+	h := 0
+	for i := 0; i < n; i++ {
+		if h > 0 {
+			h--
+		}
+		if rank[i] == 0 {
+			continue
+		}
+		j := sa[rank[i]-1]
+		for i+h < n && j+h < n && s[i+h] == s[j+h] {
+			h++
+		}
+		lcp[rank[i]-1] = h
+	}
+	return lcp
+}
+
+// LCPArrayString は、文字列sをバイト列とみなしてLCPArrayを返します.
+func LCPArrayString(s string, sa []int) []int {
+	return LCPArray(stringToInts(s), sa)
+}
+
+// CountDistinctSubstrings は、sの空でない連続部分列の種類数を返します. 接尾辞配列とLCP配列を利用するため、計算量はO(N log N)です.
+func CountDistinctSubstrings(s []int) int {
+	n := len(s)
+	return n*(n+1)/2 - SumInt(LCPArray(s, SuffixArray(s)))
+}
+
+// CountDistinctSubstringsString は、文字列sをバイト列とみなして、空でない部分文字列の種類数を返します.
+func CountDistinctSubstringsString(s string) int {
+	return CountDistinctSubstrings(stringToInts(s))
+}
+
+// RunLengthEncodeStr は、sを文字(rune)単位でランレングス圧縮した結果を返します.
+func RunLengthEncodeStr(s string) []RuneRun {
+	return RunLengthEncodeRune([]rune(s))
+}
+
+// RunLengthDecodeStr は、ランレングス圧縮されたrunsを元の文字列に戻して返します.
+func RunLengthDecodeStr(runs []RuneRun) string {
+	var sb strings.Builder
+	for _, r := range runs {
+		for i := 0; i < r.Count; i++ {
+			sb.WriteRune(r.Value)
+		}
+	}
+	return sb.String()
+}
+
+// LowerIndex は、英小文字cが'a'から数えて何番目(0始まり)の文字かを返します. cが英小文字でない場合は-1を返します.
+func LowerIndex(c rune) int {
+	if c < 'a' || c > 'z' {
+		return -1
+	}
+	return int(c - 'a')
+}
+
+// UpperIndex は、英大文字cが'A'から数えて何番目(0始まり)の文字かを返します. cが英大文字でない場合は-1を返します.
+func UpperIndex(c rune) int {
+	if c < 'A' || c > 'Z' {
+		return -1
+	}
+	return int(c - 'A')
+}
+
+// LowerCounts は、sに含まれる各英小文字の個数を、'a'から順に並べた配列を返します. 英小文字以外の文字は無視します.
+func LowerCounts(s string) [26]int {
+	var counts [26]int
+	for _, c := range s {
+		if i := LowerIndex(c); i >= 0 {
+			counts[i]++
+		}
+	}
+	return counts
+}
+
+// UpperCounts は、sに含まれる各英大文字の個数を、'A'から順に並べた配列を返します. 英大文字以外の文字は無視します.
+func UpperCounts(s string) [26]int {
+	var counts [26]int
+	for _, c := range s {
+		if i := UpperIndex(c); i >= 0 {
+			counts[i]++
+		}
+	}
+	return counts
+}
+
+// IsPalindromeStr は、sが文字(rune)単位で回文であるかを返します.
+func IsPalindromeStr(s string) bool {
+	return IsPalindromeRune([]rune(s))
+}
+
+// NumberToColumnName は、1以上の整数nを、a, b, ..., z, aa, ab, ..., zz, aaa, ...の順で数えたときのn番目の名前に変換します.
+// 表計算ソフトの列名と同じ規則(空の桁を持たない26進数)です. 例えば26は"z"、27は"aa"、702は"zz"になります.
+// nが1未満の場合はエラーを返します.
+func NumberToColumnName(n int) (string, error) {
+	if n < 1 {
+		return "", fmt.Errorf("n must be positive: %d", n)
+	}
+	var name []byte
+	for ; n > 0; n /= 26 {
+		// 各桁は0から25ではなく1から26をとるため、1を引いてから26で割る
+		n--
+		name = append(name, LowerAlphabet[n%26])
+	}
+	return ReverseStr(string(name)), nil
+}
+
+// ColumnNameToNumber は、NumberToColumnNameの逆変換として、列名sが何番目かを返します. 英大文字も英小文字と同様に扱います.
+// sが空の場合や英字以外を含む場合、結果がintの範囲を超える場合はエラーを返します.
+func ColumnNameToNumber(s string) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty column name is given")
+	}
+	n := 0
+	for _, c := range s {
+		d := LowerIndex(c)
+		if d < 0 {
+			d = UpperIndex(c)
+		}
+		if d < 0 {
+			return 0, fmt.Errorf("invalid character %q in column name %q", c, s)
+		}
+		v, err := CheckedMulInt(n, 26)
+		if err == nil {
+			v, err = CheckedAddInt(v, d+1)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("column name %q overflows: %v", s, err)
+		}
+		n = v
+	}
+	return n, nil
+}
+
+// RepeatJoin は、sをn回繰り返してsepで連結した文字列を返します. nが0以下の場合は空文字列を返します.
+func RepeatJoin(s string, n int, sep string) string {
+	if n <= 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.Grow(len(s)*n + len(sep)*(n-1))
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(s)
+	}
+	return sb.String()
+}
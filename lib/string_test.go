@@ -0,0 +1,260 @@
+package lib
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestZArrayString(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []int
+	}{
+		{s: "", want: []int{}},
+		{s: "abacaba", want: []int{7, 0, 1, 0, 3, 0, 1}},
+		{s: "aaaaa", want: []int{5, 4, 3, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := ZArrayString(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ZArrayString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefixFunctionString(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []int
+	}{
+		{s: "", want: []int{}},
+		{s: "abacaba", want: []int{0, 0, 1, 0, 1, 2, 3}},
+		{s: "aabaaab", want: []int{0, 1, 0, 1, 2, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := PrefixFunctionString(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrefixFunctionString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKMPSearch(t *testing.T) {
+	tests := []struct {
+		name          string
+		text, pattern string
+		want          []int
+	}{
+		{name: "overlapping", text: "aaaa", pattern: "aa", want: []int{0, 1, 2}},
+		{name: "not found", text: "abc", pattern: "abd", want: []int{}},
+		{name: "empty pattern", text: "ab", pattern: "", want: []int{0, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KMPSearchString(tt.text, tt.pattern); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KMPSearchString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	intTests := []struct {
+		name          string
+		text, pattern []int
+		want          []int
+	}{
+		{name: "重なる出現", text: []int{1, 0, 1, 0, 1}, pattern: []int{1, 0, 1}, want: []int{0, 2}},
+		{name: "負の値と大きな値", text: []int{-1, 1000000000, -1, 1000000000}, pattern: []int{-1, 1000000000}, want: []int{0, 2}},
+		{name: "パターンがテキストより長い", text: []int{1}, pattern: []int{1, 1}, want: []int{}},
+	}
+	for _, tt := range intTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KMPSearch(tt.text, tt.pattern); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KMPSearch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManacherString(t *testing.T) {
+	tests := []struct {
+		s        string
+		wantOdd  []int
+		wantEven []int
+	}{
+		{s: "", wantOdd: []int{}, wantEven: []int{}},
+		{s: "a", wantOdd: []int{1}, wantEven: []int{0}},
+		{s: "abaaba", wantOdd: []int{1, 2, 1, 1, 2, 1}, wantEven: []int{0, 0, 0, 3, 0, 0}},
+		{s: "aaaa", wantOdd: []int{1, 2, 2, 1}, wantEven: []int{0, 1, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			odd, even := ManacherString(tt.s)
+			if !reflect.DeepEqual(odd, tt.wantOdd) {
+				t.Errorf("ManacherString() odd = %v, want %v", odd, tt.wantOdd)
+			}
+			if !reflect.DeepEqual(even, tt.wantEven) {
+				t.Errorf("ManacherString() even = %v, want %v", even, tt.wantEven)
+			}
+		})
+	}
+}
+
+func TestSuffixArrayString(t *testing.T) {
+	tests := []struct {
+		s            string
+		wantSA       []int
+		wantLCP      []int
+		wantDistinct int
+	}{
+		{s: "", wantSA: []int{}, wantLCP: []int{}, wantDistinct: 0},
+		{s: "a", wantSA: []int{0}, wantLCP: []int{}, wantDistinct: 1},
+		{s: "aaaa", wantSA: []int{3, 2, 1, 0}, wantLCP: []int{1, 2, 3}, wantDistinct: 4},
+		{s: "banana", wantSA: []int{5, 3, 1, 0, 4, 2}, wantLCP: []int{1, 3, 0, 0, 2}, wantDistinct: 15},
+		{
+			s:            "mississippi",
+			wantSA:       []int{10, 7, 4, 1, 0, 9, 8, 6, 3, 5, 2},
+			wantLCP:      []int{1, 1, 4, 0, 0, 1, 0, 2, 1, 3},
+			wantDistinct: 53,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			sa := SuffixArrayString(tt.s)
+			if !reflect.DeepEqual(sa, tt.wantSA) {
+				t.Errorf("SuffixArrayString() = %v, want %v", sa, tt.wantSA)
+			}
+			if got := LCPArrayString(tt.s, sa); !reflect.DeepEqual(got, tt.wantLCP) {
+				t.Errorf("LCPArrayString() = %v, want %v", got, tt.wantLCP)
+			}
+			if got := CountDistinctSubstringsString(tt.s); got != tt.wantDistinct {
+				t.Errorf("CountDistinctSubstringsString() = %d, want %d", got, tt.wantDistinct)
+			}
+		})
+	}
+}
+
+func TestSuffixArray(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []int
+		want []int
+	}{
+		{name: "負の値を含む", s: []int{3, -1, 3, -1}, want: []int{3, 1, 2, 0}},
+		{name: "座標圧縮が必要な大きな値", s: []int{1000000000, -1000000000, 0}, want: []int{1, 2, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SuffixArray(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SuffixArray() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunLengthEncodeStr(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []RuneRun
+	}{
+		{s: "aaabccあい", want: []RuneRun{{'a', 3}, {'b', 1}, {'c', 2}, {'あ', 1}, {'い', 1}}},
+		{s: "", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got := RunLengthEncodeStr(tt.s)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RunLengthEncodeStr() = %v, want %v", got, tt.want)
+			}
+			if decoded := RunLengthDecodeStr(got); decoded != tt.s {
+				t.Errorf("RunLengthDecodeStr() = %q, want %q", decoded, tt.s)
+			}
+		})
+	}
+}
+
+func TestLowerCounts(t *testing.T) {
+	got := LowerCounts("abcaZz!")
+	var want [26]int
+	want[0], want[1], want[2], want[25] = 2, 1, 1, 1
+	if got != want {
+		t.Errorf("LowerCounts() = %v, want %v", got, want)
+	}
+	gotUpper := UpperCounts("ABaZZ")
+	var wantUpper [26]int
+	wantUpper[0], wantUpper[1], wantUpper[25] = 1, 1, 2
+	if gotUpper != wantUpper {
+		t.Errorf("UpperCounts() = %v, want %v", gotUpper, wantUpper)
+	}
+	if LowerIndex('c') != 2 || LowerIndex('C') != -1 || UpperIndex('C') != 2 || UpperIndex('c') != -1 {
+		t.Errorf("unexpected alphabet index")
+	}
+}
+
+func TestIsPalindromeStr(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{s: "", want: true},
+		{s: "racecar", want: true},
+		{s: "abba", want: true},
+		{s: "しんぶんし", want: true},
+		{s: "ab", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsPalindromeStr(tt.s); got != tt.want {
+			t.Errorf("IsPalindromeStr(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestNumberToColumnName(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{n: 1, want: "a"},
+		{n: 26, want: "z"},
+		{n: 27, want: "aa"},
+		{n: 702, want: "zz"},
+		{n: 703, want: "aaa"},
+		{n: 123456789, want: "jjddja"},
+	}
+	for _, tt := range tests {
+		if got := MustNumberToColumnName(tt.n); got != tt.want {
+			t.Errorf("NumberToColumnName(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+		if got := MustColumnNameToNumber(strings.ToUpper(tt.want)); got != tt.n {
+			t.Errorf("ColumnNameToNumber(%q) = %d, want %d", tt.want, got, tt.n)
+		}
+	}
+	if _, err := NumberToColumnName(0); err == nil {
+		t.Errorf("NumberToColumnName(0) does not return error")
+	}
+	for _, s := range []string{"", "a1", strings.Repeat("z", 20)} {
+		if _, err := ColumnNameToNumber(s); err == nil {
+			t.Errorf("ColumnNameToNumber(%q) does not return error", s)
+		}
+	}
+}
+
+func TestRepeatJoin(t *testing.T) {
+	tests := []struct {
+		s    string
+		n    int
+		sep  string
+		want string
+	}{
+		{s: "ab", n: 3, sep: ",", want: "ab,ab,ab"},
+		{s: "x", n: 1, sep: ",", want: "x"},
+		{s: "x", n: 0, sep: ",", want: ""},
+	}
+	for _, tt := range tests {
+		if got := RepeatJoin(tt.s, tt.n, tt.sep); got != tt.want {
+			t.Errorf("RepeatJoin(%q, %d, %q) = %q, want %q", tt.s, tt.n, tt.sep, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package lib
+
+import "fmt"
+
+// Trie は、文字列の集合を管理するトライ木です. 文字はoffset以上offset+alphabetSize未満のruneである必要があります.
+// 子ノードはmapではなくsliceで管理するため、ノード数をVとしてO(V*alphabetSize)のメモリを使います.
+type Trie struct {
+	alphabetSize int
+	offset       rune
+	next         []int
+	// pass[v]はノードvを通る文字列の数、end[v]はノードvで終わる文字列の数
+	pass, end []int
+}
+
+// NewTrie は、offset以上offset+alphabetSize未満の文字を扱う空のトライ木を返します.
+// 例えば英小文字のみを扱う場合はNewTrie(26, 'a')とします.
+func NewTrie(alphabetSize int, offset rune) *Trie {
+	t := &Trie{alphabetSize: alphabetSize, offset: offset}
+	t.newNode()
+	return t
+}
+
+func (t *Trie) newNode() int {
+	for i := 0; i < t.alphabetSize; i++ {
+		t.next = append(t.next, -1)
+	}
+	t.pass = append(t.pass, 0)
+	t.end = append(t.end, 0)
+	return len(t.pass) - 1
+}
+
+// child は、ノードvから文字cで遷移した先のノードを返します. 存在しない場合は-1を返します.
+func (t *Trie) child(v int, c rune) int {
+	i := int(c - t.offset)
+	if i < 0 || i >= t.alphabetSize {
+		panic(fmt.Sprintf("character %q is out of range [%q, %q)", c, t.offset, t.offset+rune(t.alphabetSize)))
+	}
+	return t.next[v*t.alphabetSize+i]
+}
+
+// find は、sに対応するノードを返します. 存在しない場合は-1を返します.
+func (t *Trie) find(s string) int {
+	v := 0
+	for _, c := range s {
+		if v = t.child(v, c); v < 0 {
+			return -1
+		}
+	}
+	return v
+}
+
+// Len は、格納されている文字列の数を返します. 同じ文字列を複数回追加した場合はそれぞれ数えます.
+func (t *Trie) Len() int {
+	return t.pass[0]
+}
+
+// Insert は、sを追加します. 計算量はO(len(s))です.
+func (t *Trie) Insert(s string) {
+	v := 0
+	t.pass[v]++
+	for _, c := range s {
+		nv := t.child(v, c)
+		if nv < 0 {
+			nv = t.newNode()
+			t.next[v*t.alphabetSize+int(c-t.offset)] = nv
+		}
+		v = nv
+		t.pass[v]++
+	}
+	t.end[v]++
+}
+
+// Erase は、sを1つ削除してtrueを返します. sが格納されていない場合は何もせずにfalseを返します.
+func (t *Trie) Erase(s string) bool {
+	if v := t.find(s); v < 0 || t.end[v] == 0 {
+		return false
+	}
+	v := 0
+	t.pass[v]--
+	for _, c := range s {
+		v = t.child(v, c)
+		t.pass[v]--
+	}
+	t.end[v]--
+	return true
+}
+
+// Count は、sと等しい文字列が格納されている数を返します.
+func (t *Trie) Count(s string) int {
+	v := t.find(s)
+	if v < 0 {
+		return 0
+	}
+	return t.end[v]
+}
+
+// CountPrefix は、prefixを接頭辞に持つ文字列が格納されている数を返します.
+func (t *Trie) CountPrefix(prefix string) int {
+	v := t.find(prefix)
+	if v < 0 {
+		return 0
+	}
+	return t.pass[v]
+}
+
+// LongestCommonPrefix は、格納されている文字列とsの最長共通接頭辞の長さ(文字数)を返します.
+func (t *Trie) LongestCommonPrefix(s string) int {
+	v, length := 0, 0
+	for _, c := range s {
+		v = t.child(v, c)
+		if v < 0 || t.pass[v] == 0 {
+			break
+		}
+		length++
+	}
+	return length
+}
+
+// BinaryTrie は、0以上の整数の多重集合を2進数のトライ木で管理し、xorの最小値や最大値を求めます.
+type BinaryTrie struct {
+	bitLen int
+	next   [][2]int
+	count  []int
+}
+
+// NewBinaryTrie は、0以上2^bitLen未満の整数を扱う空のBinaryTrieを返します.
+// bitLenは0以上63以下である必要があり、範囲外の場合はpanicします.
+func NewBinaryTrie(bitLen int) *BinaryTrie {
+	if bitLen < 0 || bitLen > 63 {
+		panic(fmt.Sprintf("bitLen %d is out of range [0, 63]", bitLen))
+	}
+	return &BinaryTrie{bitLen: bitLen, next: [][2]int{{-1, -1}}, count: []int{0}}
+}
+
+// Len は、格納されている整数の数を返します.
+func (b *BinaryTrie) Len() int {
+	return b.count[0]
+}
+
+// check は、xが0以上2^bitLen未満でない場合にpanicします.
+func (b *BinaryTrie) check(x int) {
+	if x < 0 || x>>uint(b.bitLen) != 0 {
+		panic(fmt.Sprintf("%d is out of range [0, 2^%d)", x, b.bitLen))
+	}
+}
+
+// Insert は、xを追加します. 計算量はO(bitLen)です.
+// BinaryTrieの各メソッドは、xが0以上2^bitLen未満でない場合にpanicします.
+func (b *BinaryTrie) Insert(x int) {
+	b.check(x)
+	v := 0
+	b.count[v]++
+	for i := b.bitLen - 1; i >= 0; i-- {
+		bit := x >> uint(i) & 1
+		if b.next[v][bit] < 0 {
+			b.next = append(b.next, [2]int{-1, -1})
+			b.count = append(b.count, 0)
+			b.next[v][bit] = len(b.next) - 1
+		}
+		v = b.next[v][bit]
+		b.count[v]++
+	}
+}
+
+// Erase は、xを1つ削除してtrueを返します. xが格納されていない場合は何もせずにfalseを返します.
+func (b *BinaryTrie) Erase(x int) bool {
+	if b.Count(x) == 0 {
+		return false
+	}
+	v := 0
+	b.count[v]--
+	for i := b.bitLen - 1; i >= 0; i-- {
+		v = b.next[v][x>>uint(i)&1]
+		b.count[v]--
+	}
+	return true
+}
+
+// Count は、xが格納されている数を返します.
+func (b *BinaryTrie) Count(x int) int {
+	b.check(x)
+	v := 0
+	for i := b.bitLen - 1; i >= 0; i-- {
+		if v = b.next[v][x>>uint(i)&1]; v < 0 {
+			return 0
+		}
+	}
+	return b.count[v]
+}
+
+// MinXor は、格納されている整数vのうち、x xor vの最小値を返します. 整数が1つも格納されていない場合はpanicします.
+func (b *BinaryTrie) MinXor(x int) int {
+	b.check(x)
+	if b.Len() == 0 {
+		panic("MinXor is called on empty BinaryTrie")
+	}
+	v, res := 0, 0
+	for i := b.bitLen - 1; i >= 0; i-- {
+		// xと同じビットを選べればxorのそのビットは0になる
+		bit := x >> uint(i) & 1
+		if nv := b.next[v][bit]; nv >= 0 && b.count[nv] > 0 {
+			v = nv
+		} else {
+			v = b.next[v][bit^1]
+			res |= 1 << uint(i)
+		}
+	}
+	return res
+}
+
+// MaxXor は、格納されている整数vのうち、x xor vの最大値を返します. 整数が1つも格納されていない場合はpanicします.
+func (b *BinaryTrie) MaxXor(x int) int {
+	// bitLenが63の場合もオーバーフローしないよう、符号なし整数で計算する
+	mask := int(uint64(1)<<uint(b.bitLen) - 1)
+	return b.MinXor(x^mask) ^ mask
+}
+
+// AhoCorasick は、複数のパターン文字列を同時に検索するAho-Corasickオートマトンです.
+// 文字はoffset以上offset+alphabetSize未満のruneである必要があります.
+// 状態0が初期状態で、Nextによる遷移は全ての状態と文字について定義されているため、オートマトン上のDPにも利用できます.
+type AhoCorasick struct {
+	alphabetSize int
+	offset       rune
+	next         []int
+	// matches[v]は、状態vに到達したときに末尾で一致しているパターンの数
+	matches []int
+	// terminal[i]は、i番目のパターンの末尾に対応する状態
+	terminal []int
+	// order は、状態をBFSで訪れた順に並べたもの
+	order []int
+	fail  []int
+}
+
+// NewAhoCorasick は、patternsを検索するAho-Corasickオートマトンを構築します.
+// 計算量は、パターンの長さの合計をLとしてO(L*alphabetSize)です.
+func NewAhoCorasick(patterns []string, alphabetSize int, offset rune) *AhoCorasick {
+	trie := NewTrie(alphabetSize, offset)
+	terminal := make([]int, len(patterns))
+	for i, p := range patterns {
+		trie.Insert(p)
+		terminal[i] = trie.find(p)
+	}
+
+	n := len(trie.pass)
+	a := &AhoCorasick{
+		alphabetSize: alphabetSize,
+		offset:       offset,
+		next:         trie.next,
+		matches:      append([]int{}, trie.end...),
+		terminal:     terminal,
+		order:        make([]int, 0, n),
+		fail:         make([]int, n),
+	}
+	// BFSで失敗遷移を求めつつ、存在しない遷移を失敗遷移先の遷移で埋める
+	a.order = append(a.order, 0)
+	for c := 0; c < alphabetSize; c++ {
+		if u := a.next[c]; u < 0 {
+			a.next[c] = 0
+		} else {
+			a.fail[u] = 0
+			a.order = append(a.order, u)
+		}
+	}
+	for head := 1; head < len(a.order); head++ {
+		v := a.order[head]
+		a.matches[v] += a.matches[a.fail[v]]
+		for c := 0; c < alphabetSize; c++ {
+			u := a.next[v*alphabetSize+c]
+			f := a.next[a.fail[v]*alphabetSize+c]
+			if u < 0 {
+				a.next[v*alphabetSize+c] = f
+			} else {
+				a.fail[u] = f
+				a.order = append(a.order, u)
+			}
+		}
+	}
+	return a
+}
+
+// StateNum は、オートマトンの状態数を返します.
+func (a *AhoCorasick) StateNum() int {
+	return len(a.matches)
+}
+
+// Next は、状態stateから文字cで遷移した先の状態を返します.
+func (a *AhoCorasick) Next(state int, c rune) int {
+	i := int(c - a.offset)
+	if i < 0 || i >= a.alphabetSize {
+		panic(fmt.Sprintf("character %q is out of range [%q, %q)", c, a.offset, a.offset+rune(a.alphabetSize)))
+	}
+	return a.next[state*a.alphabetSize+i]
+}
+
+// Matches は、状態stateに到達した時点で、末尾で一致しているパターンの数を返します. 同じパターンが複数ある場合はそれぞれ数えます.
+func (a *AhoCorasick) Matches(state int) int {
+	return a.matches[state]
+}
+
+// CountMatches は、text中に現れる全てのパターンの出現回数の合計を返します. 計算量はO(len(text))です.
+func (a *AhoCorasick) CountMatches(text string) int {
+	state, cnt := 0, 0
+	for _, c := range text {
+		state = a.Next(state, c)
+		cnt += a.matches[state]
+	}
+	return cnt
+}
+
+// MatchCounts は、各パターンがtext中に現れる回数を、パターンの順に並べたsliceを返します.
+// 計算量はO(len(text) + 状態数)です.
+func (a *AhoCorasick) MatchCounts(text string) []int {
+	visited := make([]int, a.StateNum())
+	state := 0
+	for _, c := range text {
+		state = a.Next(state, c)
+		visited[state]++
+	}
+	// 状態vを訪れた回数は、失敗遷移をたどった先の全ての状態にも加算される
+	for i := len(a.order) - 1; i > 0; i-- {
+		v := a.order[i]
+		visited[a.fail[v]] += visited[v]
+	}
+	counts := make([]int, len(a.terminal))
+	for i, v := range a.terminal {
+		counts[i] = visited[v]
+	}
+	return counts
+}
@@ -0,0 +1,221 @@
+package lib
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestTrie(t *testing.T) {
+	trie := NewTrie(26, 'a')
+	words := []string{"apple", "app", "apply", "banana", "app", ""}
+	for _, w := range words {
+		trie.Insert(w)
+	}
+	if got := trie.Len(); got != len(words) {
+		t.Errorf("Len() = %d, want %d", got, len(words))
+	}
+
+	countTests := []struct {
+		s                string
+		count, prefixCnt int
+		lcp              int
+	}{
+		{s: "app", count: 2, prefixCnt: 4, lcp: 3},
+		{s: "appl", count: 0, prefixCnt: 2, lcp: 4},
+		{s: "apricot", count: 0, prefixCnt: 0, lcp: 2},
+		{s: "", count: 1, prefixCnt: 6, lcp: 0},
+		{s: "cherry", count: 0, prefixCnt: 0, lcp: 0},
+	}
+	for _, tt := range countTests {
+		if got := trie.Count(tt.s); got != tt.count {
+			t.Errorf("Count(%q) = %d, want %d", tt.s, got, tt.count)
+		}
+		if got := trie.CountPrefix(tt.s); got != tt.prefixCnt {
+			t.Errorf("CountPrefix(%q) = %d, want %d", tt.s, got, tt.prefixCnt)
+		}
+		if got := trie.LongestCommonPrefix(tt.s); got != tt.lcp {
+			t.Errorf("LongestCommonPrefix(%q) = %d, want %d", tt.s, got, tt.lcp)
+		}
+	}
+
+	if trie.Erase("ap") {
+		t.Errorf("Erase(%q) returns true for not inserted string", "ap")
+	}
+	if !trie.Erase("banana") {
+		t.Errorf("Erase(%q) returns false", "banana")
+	}
+	if got := trie.LongestCommonPrefix("band"); got != 0 {
+		t.Errorf("LongestCommonPrefix(%q) after Erase = %d, want 0", "band", got)
+	}
+}
+
+func TestBinaryTrie(t *testing.T) {
+	tests := []struct {
+		name             string
+		bitLen           int
+		values           []int
+		x                int
+		wantMin, wantMax int
+	}{
+		{name: "基本", bitLen: 3, values: []int{1, 4, 6}, x: 5, wantMin: 1, wantMax: 4},
+		{name: "同じ値を複数含む", bitLen: 3, values: []int{2, 2, 7}, x: 2, wantMin: 0, wantMax: 5},
+		{name: "xが2^bitLen-1", bitLen: 10, values: []int{0, 512, 1023}, x: 1023, wantMin: 0, wantMax: 1023},
+		{name: "bitLenが63", bitLen: 63, values: []int{0, math.MaxInt64}, x: 1, wantMin: 1, wantMax: math.MaxInt64 - 1},
+		{name: "bitLenが63でxが最大値", bitLen: 63, values: []int{5, math.MaxInt64}, x: math.MaxInt64, wantMin: 0, wantMax: math.MaxInt64 - 5},
+		{name: "bitLenが0", bitLen: 0, values: []int{0}, x: 0, wantMin: 0, wantMax: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trie := NewBinaryTrie(tt.bitLen)
+			for _, v := range tt.values {
+				trie.Insert(v)
+			}
+			if got := trie.Len(); got != len(tt.values) {
+				t.Errorf("Len() = %d, want %d", got, len(tt.values))
+			}
+			if got := trie.MinXor(tt.x); got != tt.wantMin {
+				t.Errorf("MinXor(%d) = %d, want %d", tt.x, got, tt.wantMin)
+			}
+			if got := trie.MaxXor(tt.x); got != tt.wantMax {
+				t.Errorf("MaxXor(%d) = %d, want %d", tt.x, got, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestBinaryTrie_Erase(t *testing.T) {
+	trie := NewBinaryTrie(4)
+	for _, v := range []int{3, 3, 8} {
+		trie.Insert(v)
+	}
+	if trie.Erase(5) {
+		t.Errorf("Erase(5) returns true for not inserted value")
+	}
+	if !trie.Erase(3) {
+		t.Errorf("Erase(3) returns false")
+	}
+	if got := trie.Count(3); got != 1 {
+		t.Errorf("Count(3) = %d, want 1", got)
+	}
+	if !trie.Erase(3) {
+		t.Errorf("Erase(3) returns false")
+	}
+	// 削除した値はxorの計算に使われない
+	if got := trie.MinXor(3); got != 11 {
+		t.Errorf("MinXor(3) = %d, want 11", got)
+	}
+}
+
+func TestBinaryTrie_OutOfRange(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{name: "bitLenが負", f: func() { NewBinaryTrie(-1) }},
+		{name: "bitLenが64", f: func() { NewBinaryTrie(64) }},
+		{name: "負の値の追加", f: func() { NewBinaryTrie(10).Insert(-1) }},
+		{name: "2^bitLenの追加", f: func() { NewBinaryTrie(10).Insert(1 << 10) }},
+		{name: "2^bitLen以上の値のxor", f: func() {
+			trie := NewBinaryTrie(10)
+			trie.Insert(0)
+			trie.MaxXor(1 << 10)
+		}},
+		{name: "負の値の個数", f: func() { NewBinaryTrie(63).Count(math.MinInt64) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s does not panic", tt.name)
+				}
+			}()
+			tt.f()
+		})
+	}
+}
+
+func TestAhoCorasick(t *testing.T) {
+	tests := []struct {
+		name      string
+		patterns  []string
+		text      string
+		want      []int
+		wantTotal int
+	}{
+		{
+			name:      "失敗遷移をたどって一致する",
+			patterns:  []string{"he", "she", "his", "hers"},
+			text:      "ahishers",
+			want:      []int{1, 1, 1, 1},
+			wantTotal: 4,
+		},
+		{
+			name:      "重なる出現",
+			patterns:  []string{"aa", "a"},
+			text:      "aaaa",
+			want:      []int{3, 4},
+			wantTotal: 7,
+		},
+		{
+			name:      "同じパターン",
+			patterns:  []string{"ab", "ab", "b"},
+			text:      "abab",
+			want:      []int{2, 2, 2},
+			wantTotal: 6,
+		},
+		{
+			name:      "接尾辞のパターンが複数ある",
+			patterns:  []string{"abcd", "bc", "c"},
+			text:      "abcabcd",
+			want:      []int{1, 2, 2},
+			wantTotal: 5,
+		},
+		{
+			name:      "一致しない",
+			patterns:  []string{"abc"},
+			text:      "ababab",
+			want:      []int{0},
+			wantTotal: 0,
+		},
+		{
+			name:      "パターンがテキストより長い",
+			patterns:  []string{"abcd"},
+			text:      "abc",
+			want:      []int{0},
+			wantTotal: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewAhoCorasick(tt.patterns, 26, 'a')
+			if got := a.MatchCounts(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchCounts(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+			if got := a.CountMatches(tt.text); got != tt.wantTotal {
+				t.Errorf("CountMatches(%q) = %d, want %d", tt.text, got, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestAhoCorasick_Next(t *testing.T) {
+	// "ab"を含まない長さ3の文字列の数を、オートマトン上のDPで数える
+	a := NewAhoCorasick([]string{"ab"}, 2, 'a')
+	dp := make([]int, a.StateNum())
+	dp[0] = 1
+	for i := 0; i < 3; i++ {
+		ndp := make([]int, a.StateNum())
+		for s, cnt := range dp {
+			for _, c := range "ab" {
+				if ns := a.Next(s, c); a.Matches(ns) == 0 {
+					ndp[ns] += cnt
+				}
+			}
+		}
+		dp = ndp
+	}
+	if got := SumInt(dp); got != 4 {
+		t.Errorf("got %d, want 4", got)
+	}
+}
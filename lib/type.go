@@ -64,6 +64,16 @@ func ReverseZZZ(values []ZZZ) []ZZZ {
 	return newValues
 }
 
+// PermuteZZZ は、i番目の要素がvalues[perm[i]]であるSliceを返します.
+// ArgSortIntなどで得られた順序で、別のSliceを並べ替える場合に利用できます.
+func PermuteZZZ(values []ZZZ, perm []int) []ZZZ {
+	newValues := make([]ZZZ, len(perm))
+	for i, p := range perm {
+		newValues[i] = values[p]
+	}
+	return newValues
+}
+
 // MapZZZ はZZZ Sliceを引数として受け取るJavaScriptのmapです.
 func MapZZZ(values []ZZZ, f func(v ZZZ) ZZZ) (newValues []ZZZ) {
 	for _, value := range values {
@@ -205,6 +215,76 @@ func JoinZZZ(values []ZZZ, sep string) string {
 	return sb.String()
 }
 
+// JoinZZZFunc は、各要素をfで文字列に変換し、sepで連結した文字列を返します.
+func JoinZZZFunc(values []ZZZ, sep string, f func(v ZZZ) string) string {
+	var sb strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(f(v))
+	}
+	return sb.String()
+}
+
+// Join2DZZZ は、各行の要素をsepで連結し、さらに各行をlineSepで連結した文字列を返します.
+// グリッドをまとめて出力する際に便利です.
+func Join2DZZZ(grid [][]ZZZ, sep, lineSep string) string {
+	var sb strings.Builder
+	for i, line := range grid {
+		if i > 0 {
+			sb.WriteString(lineSep)
+		}
+		for j, v := range line {
+			if j > 0 {
+				sb.WriteString(sep)
+			}
+			sb.WriteString(fmt.Sprint(v))
+		}
+	}
+	return sb.String()
+}
+
+// ZZZRun は、ランレングス圧縮における、同じ値Valueの連続するCount個の要素です.
+type ZZZRun struct {
+	Value ZZZ
+	Count int
+}
+
+// RunLengthEncodeZZZ は、valuesをランレングス圧縮した結果を返します.
+func RunLengthEncodeZZZ(values []ZZZ) []ZZZRun {
+	var runs []ZZZRun
+	for _, v := range values {
+		if len(runs) > 0 && runs[len(runs)-1].Value == v {
+			runs[len(runs)-1].Count++
+			continue
+		}
+		runs = append(runs, ZZZRun{Value: v, Count: 1})
+	}
+	return runs
+}
+
+// RunLengthDecodeZZZ は、ランレングス圧縮されたrunsを元のsliceに戻して返します.
+func RunLengthDecodeZZZ(runs []ZZZRun) []ZZZ {
+	var values []ZZZ
+	for _, r := range runs {
+		for i := 0; i < r.Count; i++ {
+			values = append(values, r.Value)
+		}
+	}
+	return values
+}
+
+// IsPalindromeZZZ は、valuesが回文である(反転しても等しい)かを返します.
+func IsPalindromeZZZ(values []ZZZ) bool {
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		if values[i] != values[j] {
+			return false
+		}
+	}
+	return true
+}
+
 func PrintZZZSlice(values []ZZZ, sep string) {
 	for i := 0; i < len(values)-1; i++ {
 		fmt.Print(values[i], sep)
@@ -213,6 +293,7 @@ func PrintZZZSlice(values []ZZZ, sep string) {
 }
 
 // ZZZCombination は、与えられた値からr個を取り出す場合の全組み合わせを返します.
+// 全ての組み合わせをメモリ上に保持するため、組み合わせの数が多い場合はNewCombinationGeneratorを利用してください.
 func ZZZCombination(values []ZZZ, r int) (combinations [][]ZZZ, err error) {
 	if r == 1 {
 		for _, value := range values {
@@ -232,7 +313,8 @@ func ZZZCombination(values []ZZZ, r int) (combinations [][]ZZZ, err error) {
 		}
 
 		for _, pc := range partialCombinations {
-			newC := append(pc, values[i])
+			// pcの容量に余裕がある場合に他の結果と領域を共有しないよう、コピーしてから追加する
+			newC := append(append(make([]ZZZ, 0, len(pc)+1), pc...), values[i])
 			combinations = append(combinations, newC)
 		}
 	}
@@ -240,6 +322,7 @@ func ZZZCombination(values []ZZZ, r int) (combinations [][]ZZZ, err error) {
 }
 
 // ZZZPermutation は、与えらえれた値からr個を順序考慮して取り出す全組み合わせを返します。
+// 全ての順列をメモリ上に保持するため、順列の数が多い場合はNewPermutationGeneratorを利用してください.
 func ZZZPermutation(values []ZZZ, r int) (permutations [][]ZZZ, err error) {
 	if r == 1 {
 		for _, value := range values {
@@ -258,7 +341,7 @@ func ZZZPermutation(values []ZZZ, r int) (permutations [][]ZZZ, err error) {
 		}
 
 		for _, pc := range perm {
-			newC := append(pc, values[i])
+			newC := append(append(make([]ZZZ, 0, len(pc)+1), pc...), values[i])
 			permutations = append(permutations, newC)
 		}
 	}
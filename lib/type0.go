@@ -1,5 +1,10 @@
 package lib
 
+import (
+	"math/bits"
+	"sort"
+)
+
 // ReverseSt　は、引数の文字列を反転させた文字列を返します.
 func ReverseStr(s string) string {
 	runes := []rune(s)
@@ -34,3 +39,169 @@ func (b *bitGenerator) Next() []bool {
 	b.cur++
 	return r
 }
+
+// NextPermutation は、pを辞書順で次の順列に並べ替えてtrueを返します. pが辞書順で最後の順列の場合は、pを昇順に並べ替えてfalseを返します.
+// 重複する値を含む場合は、同じ順列を重複せずに列挙します. 計算量はO(len(p))です.
+func NextPermutation(p []int) bool {
+	i := len(p) - 2
+	for i >= 0 && p[i] >= p[i+1] {
+		i--
+	}
+	if i < 0 {
+		reverseIntsInPlace(p)
+		return false
+	}
+	j := len(p) - 1
+	for p[j] <= p[i] {
+		j--
+	}
+	p[i], p[j] = p[j], p[i]
+	reverseIntsInPlace(p[i+1:])
+	return true
+}
+
+// reverseIntsInPlace は、pの要素の順序をその場で反転させます.
+func reverseIntsInPlace(p []int) {
+	for i, j := 0, len(p)-1; i < j; i, j = i+1, j-1 {
+		p[i], p[j] = p[j], p[i]
+	}
+}
+
+type permutationGenerator struct {
+	cur     []int
+	started bool
+	done    bool
+}
+
+// NewPermutationGenerator は、0からn-1までの整数の全順列を辞書順に返すgeneratorを返します.
+// 順列全探索を行う際に、全ての順列をメモリ上に保持せずに列挙できます.
+func NewPermutationGenerator(n int) *permutationGenerator {
+	cur := make([]int, n)
+	for i := range cur {
+		cur[i] = i
+	}
+	return &permutationGenerator{cur: cur}
+}
+
+// NewMultisetPermutationGenerator は、重複を含む値valuesの並べ替えを、重複なく辞書順に返すgeneratorを返します.
+// valuesは変更されません.
+func NewMultisetPermutationGenerator(values []int) *permutationGenerator {
+	cur := append([]int{}, values...)
+	sort.Ints(cur)
+	return &permutationGenerator{cur: cur}
+}
+
+// Next は、次の順列を返します. 全ての順列を返し終えた場合はnilを返します. 計算量は償却O(1)です.
+// 返されるsliceは次回のNext呼び出しで書き換えられるため、保持する場合はコピーしてください.
+func (p *permutationGenerator) Next() []int {
+	if p.done {
+		return nil
+	}
+	if !p.started {
+		p.started = true
+		return p.cur
+	}
+	if !NextPermutation(p.cur) {
+		p.done = true
+		return nil
+	}
+	return p.cur
+}
+
+type combinationGenerator struct {
+	cur     []int
+	n       int
+	started bool
+	done    bool
+}
+
+// NewCombinationGenerator は、0からn-1までの整数からk個を選ぶ全組み合わせを、昇順のindexの配列として辞書順に返すgeneratorを返します.
+func NewCombinationGenerator(n, k int) *combinationGenerator {
+	if k < 0 || k > n {
+		return &combinationGenerator{n: n, done: true}
+	}
+	cur := make([]int, k)
+	for i := range cur {
+		cur[i] = i
+	}
+	return &combinationGenerator{cur: cur, n: n}
+}
+
+// Next は、次の組み合わせを返します. 全ての組み合わせを返し終えた場合はnilを返します. 計算量はO(k)です.
+// 返されるsliceは次回のNext呼び出しで書き換えられるため、保持する場合はコピーしてください.
+func (c *combinationGenerator) Next() []int {
+	if c.done {
+		return nil
+	}
+	if !c.started {
+		c.started = true
+		return c.cur
+	}
+	k := len(c.cur)
+	// 末尾から、まだ増やせる位置を探す
+	i := k - 1
+	for i >= 0 && c.cur[i] == c.n-k+i {
+		i--
+	}
+	if i < 0 {
+		c.done = true
+		return nil
+	}
+	c.cur[i]++
+	for j := i + 1; j < k; j++ {
+		c.cur[j] = c.cur[j-1] + 1
+	}
+	return c.cur
+}
+
+type grayCodeGenerator struct {
+	cur int
+	max int
+}
+
+// NewGrayCodeGenerator は、n要素の集合の全部分集合を、1つ前の部分集合と1要素だけ異なる順(グレイコード順)に返すgeneratorを返します.
+// 要素の追加・削除が高速に行える状態を持ちながらbit全探索を行う際に便利です.
+func NewGrayCodeGenerator(n int) *grayCodeGenerator {
+	return &grayCodeGenerator{cur: 0, max: 1 << n}
+}
+
+// Next は、次の部分集合をビットマスクとして返します. changedは直前の部分集合から変化した要素の番号で、最初の部分集合(空集合)では-1です.
+// 全ての部分集合を返し終えた場合はokがfalseになります. 計算量はO(1)です.
+func (g *grayCodeGenerator) Next() (mask, changed int, ok bool) {
+	if g.cur == g.max {
+		return 0, 0, false
+	}
+	mask = g.cur ^ (g.cur >> 1)
+	changed = -1
+	if g.cur > 0 {
+		changed = bits.TrailingZeros(uint(g.cur))
+	}
+	g.cur++
+	return mask, changed, true
+}
+
+type submaskGenerator struct {
+	cur  int
+	mask int
+	done bool
+}
+
+// NewSubmaskGenerator は、maskの全ての部分集合(mask自身と0を含む)を降順に返すgeneratorを返します.
+// 全てのmaskについて部分集合を列挙する場合、全体の計算量はnをビット数としてO(3^n)です.
+func NewSubmaskGenerator(mask int) *submaskGenerator {
+	return &submaskGenerator{cur: mask, mask: mask}
+}
+
+// Next は、次の部分集合を返します. 全ての部分集合を返し終えた場合はokがfalseになります. 計算量はO(1)です.
+func (s *submaskGenerator) Next() (sub int, ok bool) {
+	if s.done {
+		return 0, false
+	}
+	sub = s.cur
+	if s.cur == 0 {
+		s.done = true
+	} else {
+		s.cur = (s.cur - 1) & s.mask
+	}
+	return sub, true
+}
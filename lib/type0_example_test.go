@@ -14,3 +14,19 @@ func ExampleNewBitGenerator() {
 	// [false true]
 	// [true true]
 }
+
+func ExampleNewPermutationGenerator() {
+	names := []string{"a", "b", "c"}
+	gen := NewPermutationGenerator(len(names))
+	for p := gen.Next(); p != nil; p = gen.Next() {
+		fmt.Println(PermuteString(names, p))
+	}
+
+	// Output:
+	// [a b c]
+	// [a c b]
+	// [b a c]
+	// [b c a]
+	// [c a b]
+	// [c b a]
+}
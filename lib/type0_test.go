@@ -35,3 +35,153 @@ func Test_bitGenerator_Next(t *testing.T) {
 		})
 	}
 }
+
+func TestNextPermutation(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        []int
+		wantP    []int
+		wantNext bool
+	}{
+		{name: "middle", p: []int{1, 3, 2}, wantP: []int{2, 1, 3}, wantNext: true},
+		{name: "with duplicates", p: []int{1, 2, 2, 1}, wantP: []int{2, 1, 1, 2}, wantNext: true},
+		{name: "last", p: []int{3, 2, 1}, wantP: []int{1, 2, 3}, wantNext: false},
+		{name: "empty", p: []int{}, wantP: []int{}, wantNext: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextPermutation(tt.p); got != tt.wantNext {
+				t.Errorf("NextPermutation() = %v, want %v", got, tt.wantNext)
+			}
+			if !reflect.DeepEqual(tt.p, tt.wantP) {
+				t.Errorf("p = %v, want %v", tt.p, tt.wantP)
+			}
+		})
+	}
+}
+
+func Test_permutationGenerator_Next(t *testing.T) {
+	tests := []struct {
+		name string
+		gen  *permutationGenerator
+		want [][]int
+	}{
+		{
+			name: "permutation",
+			gen:  NewPermutationGenerator(3),
+			want: [][]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}},
+		},
+		{
+			name: "empty permutation",
+			gen:  NewPermutationGenerator(0),
+			want: [][]int{{}},
+		},
+		{
+			name: "multiset permutation",
+			gen:  NewMultisetPermutationGenerator([]int{2, 1, 1}),
+			want: [][]int{{1, 1, 2}, {1, 2, 1}, {2, 1, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][]int
+			for p := tt.gen.Next(); p != nil; p = tt.gen.Next() {
+				got = append(got, append([]int{}, p...))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_combinationGenerator_Next(t *testing.T) {
+	tests := []struct {
+		name string
+		n, k int
+		want [][]int
+	}{
+		{
+			name: "4個から2個",
+			n:    4,
+			k:    2,
+			want: [][]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}},
+		},
+		{
+			name: "全て選ぶ",
+			n:    3,
+			k:    3,
+			want: [][]int{{0, 1, 2}},
+		},
+		{
+			name: "1つも選ばない",
+			n:    3,
+			k:    0,
+			want: [][]int{{}},
+		},
+		{
+			name: "kがnより大きい",
+			n:    2,
+			k:    3,
+			want: nil,
+		},
+		{
+			name: "kが負",
+			n:    2,
+			k:    -1,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := NewCombinationGenerator(tt.n, tt.k)
+			var got [][]int
+			for c := gen.Next(); c != nil; c = gen.Next() {
+				got = append(got, append([]int{}, c...))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_grayCodeGenerator_Next(t *testing.T) {
+	gen := NewGrayCodeGenerator(3)
+	var gotMasks, gotChanged []int
+	for mask, changed, ok := gen.Next(); ok; mask, changed, ok = gen.Next() {
+		gotMasks = append(gotMasks, mask)
+		gotChanged = append(gotChanged, changed)
+	}
+	wantMasks := []int{0, 1, 3, 2, 6, 7, 5, 4}
+	wantChanged := []int{-1, 0, 1, 0, 2, 0, 1, 0}
+	if !reflect.DeepEqual(gotMasks, wantMasks) {
+		t.Errorf("masks = %v, want %v", gotMasks, wantMasks)
+	}
+	if !reflect.DeepEqual(gotChanged, wantChanged) {
+		t.Errorf("changed = %v, want %v", gotChanged, wantChanged)
+	}
+}
+
+func Test_submaskGenerator_Next(t *testing.T) {
+	tests := []struct {
+		name string
+		mask int
+		want []int
+	}{
+		{mask: 0b1011, want: []int{0b1011, 0b1010, 0b1001, 0b1000, 0b0011, 0b0010, 0b0001, 0}},
+		{mask: 0, want: []int{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := NewSubmaskGenerator(tt.mask)
+			var got []int
+			for sub, ok := gen.Next(); ok; sub, ok = gen.Next() {
+				got = append(got, sub)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
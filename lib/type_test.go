@@ -1,6 +1,7 @@
 package lib
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -365,6 +366,48 @@ func TestZZZPermutation(t *testing.T) {
 	}
 }
 
+func TestZZZCombination_Independent(t *testing.T) {
+	tests := []struct {
+		name     string
+		generate func(values []ZZZ, r int) ([][]ZZZ, error)
+		values   []ZZZ
+		r        int
+	}{
+		{name: "ZZZCombination", generate: ZZZCombination, values: []ZZZ{1, 2, 3, 4, 5, 6}, r: 4},
+		{name: "ZZZPermutation", generate: ZZZPermutation, values: []ZZZ{1, 2, 3, 4, 5}, r: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.generate(tt.values, tt.r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := make([][]ZZZ, len(got))
+			for i, g := range got {
+				want[i] = append([]ZZZ{}, g...)
+			}
+
+			// 再度生成しても、先に得た結果は変わらない
+			if _, err := tt.generate(tt.values, tt.r); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s() results changed after generating again", tt.name)
+			}
+
+			// 後ろの結果を書き換えても、前の結果は変わらない
+			for i := len(got) - 1; i > 0; i-- {
+				for j := range got[i] {
+					got[i][j] = 0
+				}
+				if !reflect.DeepEqual(got[:i], want[:i]) {
+					t.Fatalf("%s() results before %d changed after overwriting %v", tt.name, i, want[i])
+				}
+			}
+		})
+	}
+}
+
 func TestMapZZZSlice(t *testing.T) {
 	type args struct {
 		values [][]ZZZ
@@ -893,3 +936,59 @@ func TestJoinZZZ(t *testing.T) {
 		})
 	}
 }
+
+func TestJoin2DZZZ(t *testing.T) {
+	got := Join2DZZZ([][]ZZZ{{1, 2}, {3}, {}}, " ", "\n")
+	if want := "1 2\n3\n"; got != want {
+		t.Errorf("Join2DZZZ() = %q, want %q", got, want)
+	}
+	got = JoinZZZFunc([]ZZZ{1, 2}, ",", func(v ZZZ) string { return fmt.Sprintf("<%v>", v) })
+	if want := "<1>,<2>"; got != want {
+		t.Errorf("JoinZZZFunc() = %q, want %q", got, want)
+	}
+}
+
+func TestRunLengthEncodeZZZ(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []ZZZ
+		want   []ZZZRun
+	}{
+		{
+			values: []ZZZ{1, 1, 2, 1, 1, 1},
+			want:   []ZZZRun{{Value: 1, Count: 2}, {Value: 2, Count: 1}, {Value: 1, Count: 3}},
+		},
+		{
+			values: nil,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RunLengthEncodeZZZ(tt.values)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RunLengthEncodeZZZ() = %v, want %v", got, tt.want)
+			}
+			if decoded := RunLengthDecodeZZZ(got); !reflect.DeepEqual(decoded, tt.values) {
+				t.Errorf("RunLengthDecodeZZZ() = %v, want %v", decoded, tt.values)
+			}
+		})
+	}
+}
+
+func TestIsPalindromeZZZ(t *testing.T) {
+	tests := []struct {
+		values []ZZZ
+		want   bool
+	}{
+		{values: []ZZZ{1, 2, 1}, want: true},
+		{values: []ZZZ{1, 2, 2, 1}, want: true},
+		{values: []ZZZ{1, 2}, want: false},
+		{values: []ZZZ{}, want: true},
+	}
+	for _, tt := range tests {
+		if got := IsPalindromeZZZ(tt.values); got != tt.want {
+			t.Errorf("IsPalindromeZZZ(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+	}
+}
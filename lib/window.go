@@ -0,0 +1,107 @@
+package lib
+
+import "fmt"
+
+// SlidingWindowMinAAA は、長さkの全ての区間values[i:i+k]の最小値を、iの昇順に並べたsliceを返します.
+// 単調なdequeを利用するため、計算量はkによらずO(N)です. kが1未満またはlen(values)より大きい場合はエラーを返します.
+func SlidingWindowMinAAA(values []AAA, k int) ([]AAA, error) {
+	return slidingWindowAAA(values, k, func(a, b AAA) bool { return a <= b })
+}
+
+// SlidingWindowMaxAAA は、長さkの全ての区間values[i:i+k]の最大値を、iの昇順に並べたsliceを返します.
+// 単調なdequeを利用するため、計算量はkによらずO(N)です. kが1未満またはlen(values)より大きい場合はエラーを返します.
+func SlidingWindowMaxAAA(values []AAA, k int) ([]AAA, error) {
+	return slidingWindowAAA(values, k, func(a, b AAA) bool { return a >= b })
+}
+
+// slidingWindowAAA は、better(a, b)がtrueのときbより優先されるaを各区間から選んで返します.
+func slidingWindowAAA(values []AAA, k int, better func(a, b AAA) bool) ([]AAA, error) {
+	if k < 1 || k > len(values) {
+		return nil, fmt.Errorf("invalid window size: %d (len(values) = %d)", k, len(values))
+	}
+	res := make([]AAA, 0, len(values)-k+1)
+	// idx[head:]は、値がbetterの順に並ぶ、区間内の要素のindexのdequeです
+	idx := make([]int, 0, len(values))
+	head := 0
+	for i, v := range values {
+		for len(idx) > head && better(v, values[idx[len(idx)-1]]) {
+			idx = idx[:len(idx)-1]
+		}
+		idx = append(idx, i)
+		if idx[head] <= i-k {
+			head++
+		}
+		if i >= k-1 {
+			res = append(res, values[idx[head]])
+		}
+	}
+	return res, nil
+}
+
+// SWAGAAA は、結合的な演算opによる、キューに含まれる全要素の集約値を管理するSliding Window Aggregationです.
+// 2つのスタックを利用するため、Push, Pop, Foldはいずれも償却O(1)です. opは可換である必要はありません.
+// 空の場合のPopとFoldはpanicします.
+type SWAGAAA struct {
+	op                  func(a, b AAA) AAA
+	frontVals, frontAgg []AAA
+	backVals            []AAA
+	backAgg             AAA
+}
+
+// NewSWAGAAA は、演算opを利用する空のSWAGAAAを返します.
+// 例えばopにgcdを渡すと、キューに含まれる全要素のgcdを求められます.
+func NewSWAGAAA(op func(a, b AAA) AAA) *SWAGAAA {
+	return &SWAGAAA{op: op}
+}
+
+// Len は、要素数を返します.
+func (s *SWAGAAA) Len() int {
+	return len(s.frontVals) + len(s.backVals)
+}
+
+// Push は、キューの末尾にvを追加します.
+func (s *SWAGAAA) Push(v AAA) {
+	if len(s.backVals) == 0 {
+		s.backAgg = v
+	} else {
+		s.backAgg = s.op(s.backAgg, v)
+	}
+	s.backVals = append(s.backVals, v)
+}
+
+// Pop は、キューの先頭の要素を取り除いて返します.
+func (s *SWAGAAA) Pop() AAA {
+	if len(s.frontVals) == 0 {
+		// 末尾側のスタックの要素を新しい順に先頭側のスタックへ移し、先頭側から見た集約値を計算する
+		for i := len(s.backVals) - 1; i >= 0; i-- {
+			v := s.backVals[i]
+			if len(s.frontAgg) > 0 {
+				s.frontAgg = append(s.frontAgg, s.op(v, s.frontAgg[len(s.frontAgg)-1]))
+			} else {
+				s.frontAgg = append(s.frontAgg, v)
+			}
+			s.frontVals = append(s.frontVals, v)
+		}
+		s.backVals = s.backVals[:0]
+	}
+	if len(s.frontVals) == 0 {
+		panic("Pop is called on empty SWAG")
+	}
+	last := len(s.frontVals) - 1
+	v := s.frontVals[last]
+	s.frontVals, s.frontAgg = s.frontVals[:last], s.frontAgg[:last]
+	return v
+}
+
+// Fold は、キューに含まれる全ての要素を先頭から順にopで集約した値を返します.
+func (s *SWAGAAA) Fold() AAA {
+	switch {
+	case len(s.frontVals) == 0 && len(s.backVals) == 0:
+		panic("Fold is called on empty SWAG")
+	case len(s.frontVals) == 0:
+		return s.backAgg
+	case len(s.backVals) == 0:
+		return s.frontAgg[len(s.frontAgg)-1]
+	}
+	return s.op(s.frontAgg[len(s.frontAgg)-1], s.backAgg)
+}
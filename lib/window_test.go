@@ -0,0 +1,114 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSlidingWindowAAA(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []AAA
+		k       int
+		wantMin []AAA
+		wantMax []AAA
+		wantErr bool
+	}{
+		{
+			name:    "長さ3の区間",
+			values:  []AAA{3, 1, 4, 1, 5, 9, 2, 6},
+			k:       3,
+			wantMin: []AAA{1, 1, 1, 1, 2, 2},
+			wantMax: []AAA{4, 4, 5, 9, 9, 9},
+		},
+		{
+			name:    "長さ1の区間は元の列と一致する",
+			values:  []AAA{2, -1, 3},
+			k:       1,
+			wantMin: []AAA{2, -1, 3},
+			wantMax: []AAA{2, -1, 3},
+		},
+		{
+			name:    "区間が列全体",
+			values:  []AAA{2, -1, 3},
+			k:       3,
+			wantMin: []AAA{-1},
+			wantMax: []AAA{3},
+		},
+		{
+			name:    "同じ値が連続する",
+			values:  []AAA{5, 5, 4, 4},
+			k:       2,
+			wantMin: []AAA{5, 4, 4},
+			wantMax: []AAA{5, 5, 4},
+		},
+		{
+			name:    "区間の長さが0",
+			values:  []AAA{1, 2, 3},
+			k:       0,
+			wantErr: true,
+		},
+		{
+			name:    "区間の長さが列より長い",
+			values:  []AAA{1, 2, 3},
+			k:       4,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMin, err := SlidingWindowMinAAA(tt.values, tt.k)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SlidingWindowMinAAA() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			gotMax, err := SlidingWindowMaxAAA(tt.values, tt.k)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SlidingWindowMaxAAA() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(gotMin, tt.wantMin) {
+				t.Errorf("SlidingWindowMinAAA() = %v, want %v", gotMin, tt.wantMin)
+			}
+			if !reflect.DeepEqual(gotMax, tt.wantMax) {
+				t.Errorf("SlidingWindowMaxAAA() = %v, want %v", gotMax, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestSWAGAAA(t *testing.T) {
+	// 非可換な演算として、1桁の数を10進数の末尾に連結する演算を使う
+	s := NewSWAGAAA(func(a, b AAA) AAA { return a*10 + b })
+	steps := []struct {
+		name     string
+		push     AAA
+		wantPop  AAA
+		wantLen  int
+		wantFold AAA
+	}{
+		{name: "Push(1)", push: 1, wantLen: 1, wantFold: 1},
+		{name: "Push(2)", push: 2, wantLen: 2, wantFold: 12},
+		{name: "Push(3)", push: 3, wantLen: 3, wantFold: 123},
+		{name: "末尾側から先頭側へ移してPop", wantPop: 1, wantLen: 2, wantFold: 23},
+		{name: "両側に要素がある状態でPush(4)", push: 4, wantLen: 3, wantFold: 234},
+		{name: "先頭側からPop", wantPop: 2, wantLen: 2, wantFold: 34},
+		{name: "先頭側が空になるPop", wantPop: 3, wantLen: 1, wantFold: 4},
+		{name: "Push(5)", push: 5, wantLen: 2, wantFold: 45},
+		{name: "再び末尾側から移してPop", wantPop: 4, wantLen: 1, wantFold: 5},
+	}
+	for _, st := range steps {
+		if st.push != 0 {
+			s.Push(st.push)
+		} else if got := s.Pop(); got != st.wantPop {
+			t.Fatalf("%s: Pop() = %v, want %v", st.name, got, st.wantPop)
+		}
+		if got := s.Len(); got != st.wantLen {
+			t.Fatalf("%s: Len() = %d, want %d", st.name, got, st.wantLen)
+		}
+		if got := s.Fold(); got != st.wantFold {
+			t.Fatalf("%s: Fold() = %v, want %v", st.name, got, st.wantFold)
+		}
+	}
+}